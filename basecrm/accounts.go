@@ -1,7 +1,7 @@
 package basecrm
 
 import (
-	"time"
+	"context"
 )
 
 type Account struct {
@@ -13,8 +13,14 @@ type Account struct {
 	TimeFormat string    `json:"time_format,omitempty"`
 	Timezone   string    `json:"timezone,omitempty"`
 	Phone      string    `json:"phone,omitempty"`
-	UpdatedAt  time.Time `json:"updated_at,omitempty"`
-	CreatedAt  time.Time `json:"created_at,omitempty"`
+	UpdatedAt  Timestamp `json:"updated_at,omitempty"`
+	CreatedAt  Timestamp `json:"created_at,omitempty"`
+}
+
+// MarshalJSON encodes the Account, omitting its unset timestamps.
+func (a Account) MarshalJSON() ([]byte, error) {
+	type account Account
+	return marshalFields(account(a), nil, nil)
 }
 
 func (a *Account) String() string {
@@ -23,6 +29,7 @@ func (a *Account) String() string {
 
 type AccountsService interface {
 	Self() (*Account, *Response, error)
+	SelfContext(ctx context.Context) (*Account, *Response, error)
 }
 
 func NewAccountsService(client *Client) AccountsService {
@@ -41,8 +48,12 @@ type accountRoot struct {
 }
 
 func (s *AccountsServiceOp) Self() (*Account, *Response, error) {
+	return s.SelfContext(context.Background())
+}
+
+func (s *AccountsServiceOp) SelfContext(ctx context.Context) (*Account, *Response, error) {
 	u := "/v2/accounts/self"
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
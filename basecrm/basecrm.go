@@ -2,6 +2,7 @@ package basecrm
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -9,6 +10,7 @@ import (
 	"net/http"
 	"net/url"
 	"reflect"
+	"sync"
 
 	"github.com/google/go-querystring/query"
 )
@@ -19,6 +21,8 @@ const (
 	userAgent      = "basecrm-go/" + libraryVersion
 
 	defaultMediaType = "application/json"
+
+	headerRequestId = "X-Request-Id"
 )
 
 type ResourceType string
@@ -41,17 +45,45 @@ type Client struct {
 	// User Agent for the API Client
 	UserAgent string
 
+	// Policy used to retry failed requests. Defaults to DefaultRetryPolicy;
+	// requests are not retried if nil.
+	RetryPolicy *RetryPolicy
+
+	// Rate limit reported by the most recent API response.
+	rateMu    sync.Mutex
+	rateLimit RateLimit
+
 	// Services used to communicating with the API.
-	Accounts    AccountsService
-	Users       UsersService
-	Contacts    ContactsService
-	Sources     SourcesService
-	LossReasons LossReasonsService
-	Leads       LeadsService
-	Deals       DealsService
-	Notes       NotesService
-	Tasks       TasksService
-	Tags        TagsService
+	Accounts               AccountsService
+	Users                  UsersService
+	Contacts               ContactsService
+	Sources                SourcesService
+	LossReasons            LossReasonsService
+	Leads                  LeadsService
+	Deals                  DealsService
+	Notes                  NotesService
+	Tasks                  TasksService
+	Tags                   TagsService
+	Pipelines              PipelinesService
+	Stages                 StagesService
+	Products               ProductsService
+	Orders                 OrdersService
+	LineItems              LineItemsService
+	Calls                  CallsService
+	CallOutcomes           CallOutcomesService
+	TextMessages           TextMessagesService
+	Visits                 VisitsService
+	VisitOutcomes          VisitOutcomesService
+	LeadUnqualifiedReasons LeadUnqualifiedReasonsService
+	DealUnqualifiedReasons DealUnqualifiedReasonsService
+	LeadSources            LeadSourcesService
+	DealSources            DealSourcesService
+	LeadConversions        LeadConversionsService
+	CustomFields           CustomFieldsService
+	Collaborations         CollaborationsService
+	Sync                   SyncService
+	Firehose               FirehoseService
+	Search                 SearchService
 }
 
 // ListOptions specifies the optional parameters to various List methods that
@@ -84,9 +116,10 @@ func NewClient(httpClient *http.Client) *Client {
 	baseURL, _ := url.Parse(defaultBaseURL)
 
 	c := &Client{
-		client:    httpClient,
-		BaseURL:   baseURL,
-		UserAgent: userAgent,
+		client:      httpClient,
+		BaseURL:     baseURL,
+		UserAgent:   userAgent,
+		RetryPolicy: DefaultRetryPolicy(),
 	}
 
 	c.Accounts = NewAccountsService(c)
@@ -99,6 +132,26 @@ func NewClient(httpClient *http.Client) *Client {
 	c.Notes = NewNotesService(c)
 	c.Tasks = NewTasksService(c)
 	c.Tags = NewTagsService(c)
+	c.Pipelines = NewPipelinesService(c)
+	c.Stages = NewStagesService(c)
+	c.Products = NewProductsService(c)
+	c.Orders = NewOrdersService(c)
+	c.LineItems = NewLineItemsService(c)
+	c.Calls = NewCallsService(c)
+	c.CallOutcomes = NewCallOutcomesService(c)
+	c.TextMessages = NewTextMessagesService(c)
+	c.Visits = NewVisitsService(c)
+	c.VisitOutcomes = NewVisitOutcomesService(c)
+	c.LeadUnqualifiedReasons = NewLeadUnqualifiedReasonsService(c)
+	c.DealUnqualifiedReasons = NewDealUnqualifiedReasonsService(c)
+	c.LeadSources = NewLeadSourcesService(c)
+	c.DealSources = NewDealSourcesService(c)
+	c.LeadConversions = NewLeadConversionsService(c)
+	c.CustomFields = NewCustomFieldsService(c)
+	c.Collaborations = NewCollaborationsService(c)
+	c.Sync = NewSyncService(c)
+	c.Firehose = NewFirehoseService(c)
+	c.Search = NewSearchService(c)
 
 	return c
 }
@@ -109,7 +162,17 @@ func NewClient(httpClient *http.Client) *Client {
 type Response struct {
 	*http.Response
 
+	// Meta data of collection responses, nil for single resources.
 	Meta *Meta
+
+	// Page numbers extracted from Meta links. Zero when there is no such page.
+	FirstPage int
+	PrevPage  int
+	NextPage  int
+	LastPage  int
+
+	// Rate limit reported by the API in the response headers.
+	RateLimit RateLimit
 }
 
 // An ErrorResponse reports one or more errors caused by an API request
@@ -117,20 +180,46 @@ type ErrorResponse struct {
 	// HTTP response that caused this error
 	Response *http.Response
 
-	// More details on individual errors
+	// More details on individual errors. Nil if the body was not a JSON errors envelope,
+	// e.g. an HTML page returned by a proxy.
 	Errors *ErrorsEnvelope
+
+	// Raw body of the response
+	Body []byte
+
+	// Unique id of the request, taken from the X-Request-Id header or the envelope's logref.
+	RequestId string
 }
 
 func (r *ErrorResponse) Error() string {
-	return fmt.Sprintf("%v %v: %d %v %+v",
-		r.Response.Request.Method, r.Response.Request.URL,
-		r.Response.StatusCode, r.Errors.String())
+	var buf bytes.Buffer
+	writeResponseLine(&buf, r.Response)
+
+	if r.Errors != nil && len(r.Errors.Errors) > 0 {
+		buf.WriteString(" ")
+		r.Errors.writeErrors(&buf)
+	} else if detail := bodySnippet(r.Body); detail != "" {
+		buf.WriteString(" ")
+		buf.WriteString(detail)
+	}
+
+	if r.RequestId != "" {
+		fmt.Fprintf(&buf, " (request_id=%s)", r.RequestId)
+	}
+
+	return buf.String()
 }
 
 // NewRequest creates an API request. A relative URL can be provided in urlStr, which will be resolved to the
 // BaseURL of the Client. Relative URLS should always be specified without a preceding slash. If specified, the
 // value pointed to by body is JSON encoded and included in as the request body.
 func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Request, error) {
+	return c.NewRequestContext(context.Background(), method, urlStr, body)
+}
+
+// NewRequestContext is like NewRequest but the returned request carries ctx, so cancellation
+// and deadlines propagate into the underlying http.Client.
+func (c *Client) NewRequestContext(ctx context.Context, method, urlStr string, body interface{}) (*http.Request, error) {
 	rel, err := url.Parse(urlStr)
 	if err != nil {
 		return nil, err
@@ -146,7 +235,7 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 		}
 	}
 
-	req, err := http.NewRequest(method, u.String(), buf)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), buf)
 	if err != nil {
 		return nil, err
 	}
@@ -164,15 +253,59 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 	return req, nil
 }
 
+// Do sends an API request and returns the API response. The API response is JSON decoded and stored in the value
+// pointed to by v, or returned as an error if an API error has occurred. If v implements the io.Writer interface,
+// the raw response will be written to v, without attempting to decode it.
 func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
-	resp, err := c.client.Do(req)
+	return c.DoContext(req.Context(), req, v)
+}
+
+// DoContext is like Do but sends the request with ctx. If ctx is canceled or times out
+// before a response is received, ctx.Err() is returned.
+//
+// Failed attempts are retried according to the client's RetryPolicy.
+func (c *Client) DoContext(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	policy := c.RetryPolicy
+	if retriesDisabled(ctx) {
+		policy = nil
+	}
+
+	for attempt := 1; ; attempt++ {
+		response, err := c.do(ctx, req, v)
+
+		delay, retry := policy.next(req, attempt, response, err)
+		if !retry {
+			return response, err
+		}
+
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+
+		if req, err = rewind(req); err != nil {
+			return response, err
+		}
+	}
+}
+
+// do makes a single attempt to send the request.
+func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	resp, err := c.client.Do(req.WithContext(ctx))
 	if err != nil {
+		// prefer the context's error, it is more useful than the wrapped transport one
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
 		return nil, err
 	}
 
 	defer resp.Body.Close()
 
 	response := newResponse(resp)
+	c.setRateLimit(response.RateLimit)
 
 	if err = checkResponse(resp); err != nil {
 		// even though there was an error, we still return the response
@@ -185,6 +318,10 @@ func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
 			io.Copy(w, resp.Body)
 		} else {
 			err = json.NewDecoder(resp.Body).Decode(v)
+			if err == io.EOF {
+				// empty body, e.g. 204 No Content
+				err = nil
+			}
 			if err != nil {
 				return response, err
 			}
@@ -218,7 +355,7 @@ func addOptions(s string, opt interface{}) (string, error) {
 }
 
 func newResponse(r *http.Response) *Response {
-	return &Response{Response: r}
+	return &Response{Response: r, RateLimit: parseRateLimit(r)}
 }
 
 func checkResponse(r *http.Response) error {
@@ -228,9 +365,35 @@ func checkResponse(r *http.Response) error {
 	errorResponse := &ErrorResponse{Response: r}
 	data, err := ioutil.ReadAll(r.Body)
 
-	if err == nil && data != nil {
-		errorResponse.Errors = &ErrorsEnvelope{}
-		json.Unmarshal(data, errorResponse.Errors)
+	if err == nil && len(data) > 0 {
+		errorResponse.Body = data
+
+		envelope := &ErrorsEnvelope{}
+		if json.Unmarshal(data, envelope) == nil && (len(envelope.Errors) > 0 || envelope.Meta != nil) {
+			errorResponse.Errors = envelope
+		}
 	}
+
+	errorResponse.RequestId = r.Header.Get(headerRequestId)
+	if errorResponse.RequestId == "" && errorResponse.Errors != nil && errorResponse.Errors.Meta != nil {
+		errorResponse.RequestId = errorResponse.Errors.Meta.Logref
+	}
+
+	if r.StatusCode == http.StatusTooManyRequests {
+		return &RateLimitError{
+			ErrorResponse: errorResponse,
+			RateLimit:     parseRateLimit(r),
+		}
+	}
+
+	if r.StatusCode == http.StatusUnprocessableEntity {
+		return newValidationError(errorResponse)
+	}
+
 	return errorResponse
 }
+
+// Bool returns a pointer to v, for optional boolean options such as StageListOptions.Active.
+func Bool(v bool) *bool {
+	return &v
+}
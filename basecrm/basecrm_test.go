@@ -1,10 +1,13 @@
 package basecrm
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"reflect"
+	"testing"
+	"time"
 
 	. "gopkg.in/check.v1"
 )
@@ -24,6 +27,8 @@ func setup() {
 	client = NewClient(nil)
 	url, _ := url.Parse(server.URL)
 	client.BaseURL = url
+	// retries are tested on their own, other tests expect errors right away
+	client.RetryPolicy = nil
 }
 
 func teardown() {
@@ -134,3 +139,64 @@ func (checker *hasQueryParamsChecker) Check(params []interface{}, names []string
 
 	return reflect.DeepEqual(got, expected), ""
 }
+
+func TestClient(t *testing.T) { TestingT(t) }
+
+type ClientSuite struct {
+}
+
+var _ = Suite(&ClientSuite{})
+
+func (s *ClientSuite) TestClient_NewRequestContext(c *C) {
+	client := NewClient(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	req, err := client.NewRequestContext(ctx, "GET", "/v2/deals", nil)
+	c.Assert(err, IsNil)
+	c.Assert(req.Context(), Equals, ctx)
+	c.Assert(req.URL.String(), Equals, defaultBaseURL+"/v2/deals")
+}
+
+func (s *ClientSuite) TestClient_DoContext_Canceled(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/deals/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Error("request should not reach the server")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	req, err := client.NewRequest("GET", "/v2/deals/1", nil)
+	c.Assert(err, IsNil)
+
+	res, err := client.DoContext(ctx, req, nil)
+	c.Assert(err, Equals, context.Canceled)
+	c.Assert(res, IsNil)
+}
+
+func (s *ClientSuite) TestClient_DoContext_DeadlineExceeded(c *C) {
+	setup()
+	defer teardown()
+
+	done := make(chan struct{})
+	defer close(done)
+
+	mux.HandleFunc("/v2/deals/1", func(w http.ResponseWriter, req *http.Request) {
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	deal, res, err := client.Deals.GetContext(ctx, 1)
+	c.Assert(err, Equals, context.DeadlineExceeded)
+	c.Assert(res, IsNil)
+	c.Assert(deal, IsNil)
+}
@@ -0,0 +1,21 @@
+//go:build ignore
+
+// gen_mocks generates mocks_gen.go from the service interfaces of the basecrm package.
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/iaintshine/basecrm-go/basecrm/basecrmtest/internal/mockgen"
+)
+
+func main() {
+	src, err := mockgen.Generate("..")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("mocks_gen.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
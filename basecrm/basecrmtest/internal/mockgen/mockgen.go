@@ -0,0 +1,295 @@
+// Package mockgen generates the mocks of the basecrm service interfaces.
+package mockgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"sort"
+	"strings"
+)
+
+const header = `// Code generated by gen_mocks.go; DO NOT EDIT.
+
+package basecrmtest
+
+import (
+	"context"
+
+	"github.com/iaintshine/basecrm-go/basecrm"
+)
+`
+
+type param struct {
+	name string
+	typ  string
+}
+
+type method struct {
+	name    string
+	params  []param
+	results []string
+}
+
+type service struct {
+	name    string
+	methods []*method
+}
+
+type field struct {
+	name    string
+	service string
+}
+
+// Generate parses the basecrm package in dir and returns the source of the mocks
+// of its service interfaces.
+func Generate(dir string) ([]byte, error) {
+	fset := token.NewFileSet()
+	filter := func(fi fs.FileInfo) bool { return !strings.HasSuffix(fi.Name(), "_test.go") }
+	pkgs, err := parser.ParseDir(fset, dir, filter, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg, ok := pkgs["basecrm"]
+	if !ok {
+		return nil, fmt.Errorf("mockgen: package basecrm not found in %s", dir)
+	}
+
+	var services []*service
+	var fields []field
+	nilable := map[string]bool{}
+	for _, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				switch t := ts.Type.(type) {
+				case *ast.InterfaceType:
+					if strings.HasSuffix(ts.Name.Name, "Service") && ts.Name.IsExported() {
+						services = append(services, parseService(ts.Name.Name, t))
+					}
+				case *ast.StructType:
+					if ts.Name.Name == "Client" {
+						fields = parseClient(t)
+					}
+				case *ast.ArrayType, *ast.MapType:
+					nilable["basecrm."+ts.Name.Name] = true
+				}
+			}
+		}
+	}
+
+	sort.Slice(services, func(i, j int) bool { return services[i].name < services[j].name })
+
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	for _, s := range services {
+		writeService(&buf, s, nilable)
+	}
+	writeMocks(&buf, fields)
+
+	return format.Source(buf.Bytes())
+}
+
+func parseService(name string, t *ast.InterfaceType) *service {
+	s := &service{name: name}
+	for _, m := range t.Methods.List {
+		ft, ok := m.Type.(*ast.FuncType)
+		if !ok || len(m.Names) == 0 {
+			continue
+		}
+
+		meth := &method{name: m.Names[0].Name}
+		for _, p := range ft.Params.List {
+			typ := qualify(p.Type)
+			if len(p.Names) == 0 {
+				meth.params = append(meth.params, param{fmt.Sprintf("arg%d", len(meth.params)), typ})
+			}
+			for _, n := range p.Names {
+				meth.params = append(meth.params, param{n.Name, typ})
+			}
+		}
+		if ft.Results != nil {
+			for _, r := range ft.Results.List {
+				n := len(r.Names)
+				if n == 0 {
+					n = 1
+				}
+				for i := 0; i < n; i++ {
+					meth.results = append(meth.results, qualify(r.Type))
+				}
+			}
+		}
+		s.methods = append(s.methods, meth)
+	}
+	return s
+}
+
+func parseClient(t *ast.StructType) []field {
+	var fields []field
+	for _, f := range t.Fields.List {
+		ident, ok := f.Type.(*ast.Ident)
+		if !ok || !strings.HasSuffix(ident.Name, "Service") {
+			continue
+		}
+		for _, n := range f.Names {
+			fields = append(fields, field{n.Name, ident.Name})
+		}
+	}
+	return fields
+}
+
+// qualify formats a type expression of the basecrm package for use outside of it.
+func qualify(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if t.IsExported() {
+			return "basecrm." + t.Name
+		}
+		return t.Name
+	case *ast.SelectorExpr:
+		return qualify(t.X) + "." + t.Sel.Name
+	case *ast.StarExpr:
+		return "*" + qualify(t.X)
+	case *ast.ArrayType:
+		return "[]" + qualify(t.Elt)
+	case *ast.MapType:
+		return "map[" + qualify(t.Key) + "]" + qualify(t.Value)
+	case *ast.Ellipsis:
+		return "..." + qualify(t.Elt)
+	case *ast.InterfaceType:
+		return "interface{}"
+	case *ast.IndexExpr:
+		return qualify(t.X) + "[" + qualify(t.Index) + "]"
+	}
+	panic(fmt.Sprintf("mockgen: unsupported type %T", expr))
+}
+
+func writeService(buf *bytes.Buffer, s *service, nilable map[string]bool) {
+	mock := s.name + "Mock"
+	methods := map[string]*method{}
+	for _, m := range s.methods {
+		methods[m.name] = m
+	}
+
+	fmt.Fprintf(buf, "\n// %s is a mock of basecrm.%s.\n", mock, s.name)
+	fmt.Fprintf(buf, "// Calls are recorded, and delegated to the function of the method when set.\n")
+	fmt.Fprintf(buf, "type %s struct {\n\tRecorder\n\n", mock)
+	for _, m := range s.methods {
+		fmt.Fprintf(buf, "\t%sFunc func(%s) (%s)\n", m.name, paramList(m.params), strings.Join(m.results, ", "))
+	}
+	fmt.Fprintf(buf, "}\n\nvar _ basecrm.%s = (*%s)(nil)\n", s.name, mock)
+
+	for _, m := range s.methods {
+		fmt.Fprintf(buf, "\nfunc (m *%s) %s(%s) (%s) {\n", mock, m.name, paramList(m.params), strings.Join(m.results, ", "))
+		fmt.Fprintf(buf, "\tm.record(%q%s)\n", m.name, recordArgs(m.params))
+		fmt.Fprintf(buf, "\tif m.%sFunc != nil {\n\t\treturn m.%sFunc(%s)\n\t}\n", m.name, m.name, callArgs(m.params))
+
+		// methods without a context fall back to the function of their context variant
+		if ctxMethod, ok := methods[m.name+"Context"]; ok && sameParams(m, ctxMethod) {
+			fmt.Fprintf(buf, "\tif m.%sContextFunc != nil {\n\t\treturn m.%sContextFunc(%s)\n\t}\n", m.name, m.name, callArgs(append([]param{{"context.Background()", "context.Context"}}, m.params...)))
+		}
+
+		fmt.Fprintf(buf, "\treturn %s\n}\n", zeroList(m.results, nilable))
+	}
+}
+
+func writeMocks(buf *bytes.Buffer, fields []field) {
+	buf.WriteString("\n// Mocks holds the mocks of the services of a client returned by NewMockClient.\n")
+	buf.WriteString("type Mocks struct {\n")
+	for _, f := range fields {
+		fmt.Fprintf(buf, "\t%s *%sMock\n", f.name, f.service)
+	}
+	buf.WriteString("}\n")
+
+	buf.WriteString("\n// NewMockClient returns a client whose services are mocks.\n")
+	buf.WriteString("func NewMockClient() (*basecrm.Client, *Mocks) {\n\tm := &Mocks{\n")
+	for _, f := range fields {
+		fmt.Fprintf(buf, "\t\t%s: &%sMock{},\n", f.name, f.service)
+	}
+	buf.WriteString("\t}\n\n\tc := basecrm.NewClient(nil)\n")
+	for _, f := range fields {
+		fmt.Fprintf(buf, "\tc.%s = m.%s\n", f.name, f.name)
+	}
+	buf.WriteString("\treturn c, m\n}\n")
+}
+
+func paramList(params []param) string {
+	parts := make([]string, len(params))
+	for i, p := range params {
+		parts[i] = p.name + " " + p.typ
+	}
+	return strings.Join(parts, ", ")
+}
+
+// recordArgs formats the arguments recorded for a call, without the context.
+func recordArgs(params []param) string {
+	var b strings.Builder
+	for _, p := range params {
+		if p.typ != "context.Context" {
+			b.WriteString(", " + p.name)
+		}
+	}
+	return b.String()
+}
+
+func callArgs(params []param) string {
+	args := make([]string, len(params))
+	for i, p := range params {
+		args[i] = p.name
+		if strings.HasPrefix(p.typ, "...") {
+			args[i] += "..."
+		}
+	}
+	return strings.Join(args, ", ")
+}
+
+func sameParams(m, ctxMethod *method) bool {
+	if len(ctxMethod.params) != len(m.params)+1 || ctxMethod.params[0].typ != "context.Context" {
+		return false
+	}
+	for i, p := range m.params {
+		if ctxMethod.params[i+1].typ != p.typ {
+			return false
+		}
+	}
+	return true
+}
+
+// zeroList formats the zero values of the results. Named slice and map types
+// of the basecrm package are nilable.
+func zeroList(results []string, nilable map[string]bool) string {
+	zeros := make([]string, len(results))
+	for i, r := range results {
+		zeros[i] = zero(r)
+		if nilable[r] {
+			zeros[i] = "nil"
+		}
+	}
+	return strings.Join(zeros, ", ")
+}
+
+func zero(typ string) string {
+	switch {
+	case strings.HasPrefix(typ, "*basecrm.Iter["):
+		return "IterOf[" + strings.TrimSuffix(strings.TrimPrefix(typ, "*basecrm.Iter["), "]") + "]()"
+	case strings.HasPrefix(typ, "*"), strings.HasPrefix(typ, "[]"), strings.HasPrefix(typ, "map["),
+		typ == "error", typ == "interface{}":
+		return "nil"
+	case typ == "string":
+		return `""`
+	case typ == "bool":
+		return "false"
+	case typ == "int", typ == "int64", typ == "float64":
+		return "0"
+	}
+	return "*new(" + typ + ")"
+}
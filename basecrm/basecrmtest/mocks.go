@@ -0,0 +1,70 @@
+package basecrmtest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/iaintshine/basecrm-go/basecrm"
+)
+
+//go:generate go run gen_mocks.go
+
+// Call is a call of a mocked method. Args holds the arguments of the call, without the context.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// Recorder records the calls of a mock. It is embedded in every mock.
+type Recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (r *Recorder) record(method string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns all recorded calls, in order.
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the recorded calls of the method.
+func (r *Recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var calls []Call
+	for _, call := range r.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// ResetCalls removes all recorded calls.
+func (r *Recorder) ResetCalls() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
+
+// IterOf returns an iterator over items, to be returned by mocked ListAll methods.
+func IterOf[T any](items ...T) *basecrm.Iter[T] {
+	return basecrm.NewIter(context.Background(), 1, func(ctx context.Context, page int) ([]T, *basecrm.Response, error) {
+		return items, nil, nil
+	})
+}
+
+// IterErr returns an iterator failing with err, to be returned by mocked ListAll methods.
+func IterErr[T any](err error) *basecrm.Iter[T] {
+	return basecrm.NewIter(context.Background(), 1, func(ctx context.Context, page int) ([]T, *basecrm.Response, error) {
+		return nil, nil, err
+	})
+}
@@ -0,0 +1,3238 @@
+// Code generated by gen_mocks.go; DO NOT EDIT.
+
+package basecrmtest
+
+import (
+	"context"
+
+	"github.com/iaintshine/basecrm-go/basecrm"
+)
+
+// AccountsServiceMock is a mock of basecrm.AccountsService.
+// Calls are recorded, and delegated to the function of the method when set.
+type AccountsServiceMock struct {
+	Recorder
+
+	SelfFunc        func() (*basecrm.Account, *basecrm.Response, error)
+	SelfContextFunc func(ctx context.Context) (*basecrm.Account, *basecrm.Response, error)
+}
+
+var _ basecrm.AccountsService = (*AccountsServiceMock)(nil)
+
+func (m *AccountsServiceMock) Self() (*basecrm.Account, *basecrm.Response, error) {
+	m.record("Self")
+	if m.SelfFunc != nil {
+		return m.SelfFunc()
+	}
+	if m.SelfContextFunc != nil {
+		return m.SelfContextFunc(context.Background())
+	}
+	return nil, nil, nil
+}
+
+func (m *AccountsServiceMock) SelfContext(ctx context.Context) (*basecrm.Account, *basecrm.Response, error) {
+	m.record("SelfContext")
+	if m.SelfContextFunc != nil {
+		return m.SelfContextFunc(ctx)
+	}
+	return nil, nil, nil
+}
+
+// CallOutcomesServiceMock is a mock of basecrm.CallOutcomesService.
+// Calls are recorded, and delegated to the function of the method when set.
+type CallOutcomesServiceMock struct {
+	Recorder
+
+	ListFunc           func(opt *basecrm.CallOutcomeListOptions) ([]*basecrm.CallOutcome, *basecrm.Response, error)
+	ListContextFunc    func(ctx context.Context, opt *basecrm.CallOutcomeListOptions) ([]*basecrm.CallOutcome, *basecrm.Response, error)
+	ListAllFunc        func(opt *basecrm.CallOutcomeListOptions) *basecrm.Iter[*basecrm.CallOutcome]
+	ListAllContextFunc func(ctx context.Context, opt *basecrm.CallOutcomeListOptions) *basecrm.Iter[*basecrm.CallOutcome]
+	GetFunc            func(id int) (*basecrm.CallOutcome, *basecrm.Response, error)
+	GetContextFunc     func(ctx context.Context, id int) (*basecrm.CallOutcome, *basecrm.Response, error)
+}
+
+var _ basecrm.CallOutcomesService = (*CallOutcomesServiceMock)(nil)
+
+func (m *CallOutcomesServiceMock) List(opt *basecrm.CallOutcomeListOptions) ([]*basecrm.CallOutcome, *basecrm.Response, error) {
+	m.record("List", opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(opt)
+	}
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(context.Background(), opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *CallOutcomesServiceMock) ListContext(ctx context.Context, opt *basecrm.CallOutcomeListOptions) ([]*basecrm.CallOutcome, *basecrm.Response, error) {
+	m.record("ListContext", opt)
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(ctx, opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *CallOutcomesServiceMock) ListAll(opt *basecrm.CallOutcomeListOptions) *basecrm.Iter[*basecrm.CallOutcome] {
+	m.record("ListAll", opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(opt)
+	}
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(context.Background(), opt)
+	}
+	return IterOf[*basecrm.CallOutcome]()
+}
+
+func (m *CallOutcomesServiceMock) ListAllContext(ctx context.Context, opt *basecrm.CallOutcomeListOptions) *basecrm.Iter[*basecrm.CallOutcome] {
+	m.record("ListAllContext", opt)
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(ctx, opt)
+	}
+	return IterOf[*basecrm.CallOutcome]()
+}
+
+func (m *CallOutcomesServiceMock) Get(id int) (*basecrm.CallOutcome, *basecrm.Response, error) {
+	m.record("Get", id)
+	if m.GetFunc != nil {
+		return m.GetFunc(id)
+	}
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(context.Background(), id)
+	}
+	return nil, nil, nil
+}
+
+func (m *CallOutcomesServiceMock) GetContext(ctx context.Context, id int) (*basecrm.CallOutcome, *basecrm.Response, error) {
+	m.record("GetContext", id)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, id)
+	}
+	return nil, nil, nil
+}
+
+// CallsServiceMock is a mock of basecrm.CallsService.
+// Calls are recorded, and delegated to the function of the method when set.
+type CallsServiceMock struct {
+	Recorder
+
+	ListFunc           func(opt *basecrm.CallListOptions) ([]*basecrm.Call, *basecrm.Response, error)
+	ListContextFunc    func(ctx context.Context, opt *basecrm.CallListOptions) ([]*basecrm.Call, *basecrm.Response, error)
+	ListAllFunc        func(opt *basecrm.CallListOptions) *basecrm.Iter[*basecrm.Call]
+	ListAllContextFunc func(ctx context.Context, opt *basecrm.CallListOptions) *basecrm.Iter[*basecrm.Call]
+	GetFunc            func(id int) (*basecrm.Call, *basecrm.Response, error)
+	GetContextFunc     func(ctx context.Context, id int) (*basecrm.Call, *basecrm.Response, error)
+	CreateFunc         func(call *basecrm.Call) (*basecrm.Call, *basecrm.Response, error)
+	CreateContextFunc  func(ctx context.Context, call *basecrm.Call) (*basecrm.Call, *basecrm.Response, error)
+	EditFunc           func(id int, call *basecrm.Call) (*basecrm.Call, *basecrm.Response, error)
+	EditContextFunc    func(ctx context.Context, id int, call *basecrm.Call) (*basecrm.Call, *basecrm.Response, error)
+	DeleteFunc         func(id int) (bool, *basecrm.Response, error)
+	DeleteContextFunc  func(ctx context.Context, id int) (bool, *basecrm.Response, error)
+}
+
+var _ basecrm.CallsService = (*CallsServiceMock)(nil)
+
+func (m *CallsServiceMock) List(opt *basecrm.CallListOptions) ([]*basecrm.Call, *basecrm.Response, error) {
+	m.record("List", opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(opt)
+	}
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(context.Background(), opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *CallsServiceMock) ListContext(ctx context.Context, opt *basecrm.CallListOptions) ([]*basecrm.Call, *basecrm.Response, error) {
+	m.record("ListContext", opt)
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(ctx, opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *CallsServiceMock) ListAll(opt *basecrm.CallListOptions) *basecrm.Iter[*basecrm.Call] {
+	m.record("ListAll", opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(opt)
+	}
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(context.Background(), opt)
+	}
+	return IterOf[*basecrm.Call]()
+}
+
+func (m *CallsServiceMock) ListAllContext(ctx context.Context, opt *basecrm.CallListOptions) *basecrm.Iter[*basecrm.Call] {
+	m.record("ListAllContext", opt)
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(ctx, opt)
+	}
+	return IterOf[*basecrm.Call]()
+}
+
+func (m *CallsServiceMock) Get(id int) (*basecrm.Call, *basecrm.Response, error) {
+	m.record("Get", id)
+	if m.GetFunc != nil {
+		return m.GetFunc(id)
+	}
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(context.Background(), id)
+	}
+	return nil, nil, nil
+}
+
+func (m *CallsServiceMock) GetContext(ctx context.Context, id int) (*basecrm.Call, *basecrm.Response, error) {
+	m.record("GetContext", id)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, id)
+	}
+	return nil, nil, nil
+}
+
+func (m *CallsServiceMock) Create(call *basecrm.Call) (*basecrm.Call, *basecrm.Response, error) {
+	m.record("Create", call)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(call)
+	}
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(context.Background(), call)
+	}
+	return nil, nil, nil
+}
+
+func (m *CallsServiceMock) CreateContext(ctx context.Context, call *basecrm.Call) (*basecrm.Call, *basecrm.Response, error) {
+	m.record("CreateContext", call)
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(ctx, call)
+	}
+	return nil, nil, nil
+}
+
+func (m *CallsServiceMock) Edit(id int, call *basecrm.Call) (*basecrm.Call, *basecrm.Response, error) {
+	m.record("Edit", id, call)
+	if m.EditFunc != nil {
+		return m.EditFunc(id, call)
+	}
+	if m.EditContextFunc != nil {
+		return m.EditContextFunc(context.Background(), id, call)
+	}
+	return nil, nil, nil
+}
+
+func (m *CallsServiceMock) EditContext(ctx context.Context, id int, call *basecrm.Call) (*basecrm.Call, *basecrm.Response, error) {
+	m.record("EditContext", id, call)
+	if m.EditContextFunc != nil {
+		return m.EditContextFunc(ctx, id, call)
+	}
+	return nil, nil, nil
+}
+
+func (m *CallsServiceMock) Delete(id int) (bool, *basecrm.Response, error) {
+	m.record("Delete", id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(id)
+	}
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(context.Background(), id)
+	}
+	return false, nil, nil
+}
+
+func (m *CallsServiceMock) DeleteContext(ctx context.Context, id int) (bool, *basecrm.Response, error) {
+	m.record("DeleteContext", id)
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(ctx, id)
+	}
+	return false, nil, nil
+}
+
+// CollaborationsServiceMock is a mock of basecrm.CollaborationsService.
+// Calls are recorded, and delegated to the function of the method when set.
+type CollaborationsServiceMock struct {
+	Recorder
+
+	ListFunc           func(opt *basecrm.CollaborationListOptions) ([]*basecrm.Collaboration, *basecrm.Response, error)
+	ListContextFunc    func(ctx context.Context, opt *basecrm.CollaborationListOptions) ([]*basecrm.Collaboration, *basecrm.Response, error)
+	ListAllFunc        func(opt *basecrm.CollaborationListOptions) *basecrm.Iter[*basecrm.Collaboration]
+	ListAllContextFunc func(ctx context.Context, opt *basecrm.CollaborationListOptions) *basecrm.Iter[*basecrm.Collaboration]
+	GetFunc            func(id int) (*basecrm.Collaboration, *basecrm.Response, error)
+	GetContextFunc     func(ctx context.Context, id int) (*basecrm.Collaboration, *basecrm.Response, error)
+	CreateFunc         func(collaboration *basecrm.Collaboration) (*basecrm.Collaboration, *basecrm.Response, error)
+	CreateContextFunc  func(ctx context.Context, collaboration *basecrm.Collaboration) (*basecrm.Collaboration, *basecrm.Response, error)
+	DeleteFunc         func(id int) (bool, *basecrm.Response, error)
+	DeleteContextFunc  func(ctx context.Context, id int) (bool, *basecrm.Response, error)
+}
+
+var _ basecrm.CollaborationsService = (*CollaborationsServiceMock)(nil)
+
+func (m *CollaborationsServiceMock) List(opt *basecrm.CollaborationListOptions) ([]*basecrm.Collaboration, *basecrm.Response, error) {
+	m.record("List", opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(opt)
+	}
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(context.Background(), opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *CollaborationsServiceMock) ListContext(ctx context.Context, opt *basecrm.CollaborationListOptions) ([]*basecrm.Collaboration, *basecrm.Response, error) {
+	m.record("ListContext", opt)
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(ctx, opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *CollaborationsServiceMock) ListAll(opt *basecrm.CollaborationListOptions) *basecrm.Iter[*basecrm.Collaboration] {
+	m.record("ListAll", opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(opt)
+	}
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(context.Background(), opt)
+	}
+	return IterOf[*basecrm.Collaboration]()
+}
+
+func (m *CollaborationsServiceMock) ListAllContext(ctx context.Context, opt *basecrm.CollaborationListOptions) *basecrm.Iter[*basecrm.Collaboration] {
+	m.record("ListAllContext", opt)
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(ctx, opt)
+	}
+	return IterOf[*basecrm.Collaboration]()
+}
+
+func (m *CollaborationsServiceMock) Get(id int) (*basecrm.Collaboration, *basecrm.Response, error) {
+	m.record("Get", id)
+	if m.GetFunc != nil {
+		return m.GetFunc(id)
+	}
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(context.Background(), id)
+	}
+	return nil, nil, nil
+}
+
+func (m *CollaborationsServiceMock) GetContext(ctx context.Context, id int) (*basecrm.Collaboration, *basecrm.Response, error) {
+	m.record("GetContext", id)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, id)
+	}
+	return nil, nil, nil
+}
+
+func (m *CollaborationsServiceMock) Create(collaboration *basecrm.Collaboration) (*basecrm.Collaboration, *basecrm.Response, error) {
+	m.record("Create", collaboration)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(collaboration)
+	}
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(context.Background(), collaboration)
+	}
+	return nil, nil, nil
+}
+
+func (m *CollaborationsServiceMock) CreateContext(ctx context.Context, collaboration *basecrm.Collaboration) (*basecrm.Collaboration, *basecrm.Response, error) {
+	m.record("CreateContext", collaboration)
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(ctx, collaboration)
+	}
+	return nil, nil, nil
+}
+
+func (m *CollaborationsServiceMock) Delete(id int) (bool, *basecrm.Response, error) {
+	m.record("Delete", id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(id)
+	}
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(context.Background(), id)
+	}
+	return false, nil, nil
+}
+
+func (m *CollaborationsServiceMock) DeleteContext(ctx context.Context, id int) (bool, *basecrm.Response, error) {
+	m.record("DeleteContext", id)
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(ctx, id)
+	}
+	return false, nil, nil
+}
+
+// ContactsServiceMock is a mock of basecrm.ContactsService.
+// Calls are recorded, and delegated to the function of the method when set.
+type ContactsServiceMock struct {
+	Recorder
+
+	ListFunc           func(opt *basecrm.ContactListOptions) ([]*basecrm.Contact, *basecrm.Response, error)
+	ListContextFunc    func(ctx context.Context, opt *basecrm.ContactListOptions) ([]*basecrm.Contact, *basecrm.Response, error)
+	ListAllFunc        func(opt *basecrm.ContactListOptions) *basecrm.Iter[*basecrm.Contact]
+	ListAllContextFunc func(ctx context.Context, opt *basecrm.ContactListOptions) *basecrm.Iter[*basecrm.Contact]
+	GetFunc            func(id int) (*basecrm.Contact, *basecrm.Response, error)
+	GetContextFunc     func(ctx context.Context, id int) (*basecrm.Contact, *basecrm.Response, error)
+	CreateFunc         func(contact *basecrm.Contact) (*basecrm.Contact, *basecrm.Response, error)
+	CreateContextFunc  func(ctx context.Context, contact *basecrm.Contact) (*basecrm.Contact, *basecrm.Response, error)
+	EditFunc           func(id int, contact *basecrm.Contact) (*basecrm.Contact, *basecrm.Response, error)
+	EditContextFunc    func(ctx context.Context, id int, contact *basecrm.Contact) (*basecrm.Contact, *basecrm.Response, error)
+	DeleteFunc         func(id int) (bool, *basecrm.Response, error)
+	DeleteContextFunc  func(ctx context.Context, id int) (bool, *basecrm.Response, error)
+}
+
+var _ basecrm.ContactsService = (*ContactsServiceMock)(nil)
+
+func (m *ContactsServiceMock) List(opt *basecrm.ContactListOptions) ([]*basecrm.Contact, *basecrm.Response, error) {
+	m.record("List", opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(opt)
+	}
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(context.Background(), opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *ContactsServiceMock) ListContext(ctx context.Context, opt *basecrm.ContactListOptions) ([]*basecrm.Contact, *basecrm.Response, error) {
+	m.record("ListContext", opt)
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(ctx, opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *ContactsServiceMock) ListAll(opt *basecrm.ContactListOptions) *basecrm.Iter[*basecrm.Contact] {
+	m.record("ListAll", opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(opt)
+	}
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(context.Background(), opt)
+	}
+	return IterOf[*basecrm.Contact]()
+}
+
+func (m *ContactsServiceMock) ListAllContext(ctx context.Context, opt *basecrm.ContactListOptions) *basecrm.Iter[*basecrm.Contact] {
+	m.record("ListAllContext", opt)
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(ctx, opt)
+	}
+	return IterOf[*basecrm.Contact]()
+}
+
+func (m *ContactsServiceMock) Get(id int) (*basecrm.Contact, *basecrm.Response, error) {
+	m.record("Get", id)
+	if m.GetFunc != nil {
+		return m.GetFunc(id)
+	}
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(context.Background(), id)
+	}
+	return nil, nil, nil
+}
+
+func (m *ContactsServiceMock) GetContext(ctx context.Context, id int) (*basecrm.Contact, *basecrm.Response, error) {
+	m.record("GetContext", id)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, id)
+	}
+	return nil, nil, nil
+}
+
+func (m *ContactsServiceMock) Create(contact *basecrm.Contact) (*basecrm.Contact, *basecrm.Response, error) {
+	m.record("Create", contact)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(contact)
+	}
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(context.Background(), contact)
+	}
+	return nil, nil, nil
+}
+
+func (m *ContactsServiceMock) CreateContext(ctx context.Context, contact *basecrm.Contact) (*basecrm.Contact, *basecrm.Response, error) {
+	m.record("CreateContext", contact)
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(ctx, contact)
+	}
+	return nil, nil, nil
+}
+
+func (m *ContactsServiceMock) Edit(id int, contact *basecrm.Contact) (*basecrm.Contact, *basecrm.Response, error) {
+	m.record("Edit", id, contact)
+	if m.EditFunc != nil {
+		return m.EditFunc(id, contact)
+	}
+	if m.EditContextFunc != nil {
+		return m.EditContextFunc(context.Background(), id, contact)
+	}
+	return nil, nil, nil
+}
+
+func (m *ContactsServiceMock) EditContext(ctx context.Context, id int, contact *basecrm.Contact) (*basecrm.Contact, *basecrm.Response, error) {
+	m.record("EditContext", id, contact)
+	if m.EditContextFunc != nil {
+		return m.EditContextFunc(ctx, id, contact)
+	}
+	return nil, nil, nil
+}
+
+func (m *ContactsServiceMock) Delete(id int) (bool, *basecrm.Response, error) {
+	m.record("Delete", id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(id)
+	}
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(context.Background(), id)
+	}
+	return false, nil, nil
+}
+
+func (m *ContactsServiceMock) DeleteContext(ctx context.Context, id int) (bool, *basecrm.Response, error) {
+	m.record("DeleteContext", id)
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(ctx, id)
+	}
+	return false, nil, nil
+}
+
+// CustomFieldsServiceMock is a mock of basecrm.CustomFieldsService.
+// Calls are recorded, and delegated to the function of the method when set.
+type CustomFieldsServiceMock struct {
+	Recorder
+
+	ListFunc        func(resourceType basecrm.ResourceType) (basecrm.CustomFieldDefinitions, *basecrm.Response, error)
+	ListContextFunc func(ctx context.Context, resourceType basecrm.ResourceType) (basecrm.CustomFieldDefinitions, *basecrm.Response, error)
+}
+
+var _ basecrm.CustomFieldsService = (*CustomFieldsServiceMock)(nil)
+
+func (m *CustomFieldsServiceMock) List(resourceType basecrm.ResourceType) (basecrm.CustomFieldDefinitions, *basecrm.Response, error) {
+	m.record("List", resourceType)
+	if m.ListFunc != nil {
+		return m.ListFunc(resourceType)
+	}
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(context.Background(), resourceType)
+	}
+	return nil, nil, nil
+}
+
+func (m *CustomFieldsServiceMock) ListContext(ctx context.Context, resourceType basecrm.ResourceType) (basecrm.CustomFieldDefinitions, *basecrm.Response, error) {
+	m.record("ListContext", resourceType)
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(ctx, resourceType)
+	}
+	return nil, nil, nil
+}
+
+// DealSourcesServiceMock is a mock of basecrm.DealSourcesService.
+// Calls are recorded, and delegated to the function of the method when set.
+type DealSourcesServiceMock struct {
+	Recorder
+
+	ListFunc           func(opt *basecrm.DealSourceListOptions) ([]*basecrm.DealSource, *basecrm.Response, error)
+	ListContextFunc    func(ctx context.Context, opt *basecrm.DealSourceListOptions) ([]*basecrm.DealSource, *basecrm.Response, error)
+	ListAllFunc        func(opt *basecrm.DealSourceListOptions) *basecrm.Iter[*basecrm.DealSource]
+	ListAllContextFunc func(ctx context.Context, opt *basecrm.DealSourceListOptions) *basecrm.Iter[*basecrm.DealSource]
+	GetFunc            func(id int) (*basecrm.DealSource, *basecrm.Response, error)
+	GetContextFunc     func(ctx context.Context, id int) (*basecrm.DealSource, *basecrm.Response, error)
+	CreateFunc         func(dealSource *basecrm.DealSource) (*basecrm.DealSource, *basecrm.Response, error)
+	CreateContextFunc  func(ctx context.Context, dealSource *basecrm.DealSource) (*basecrm.DealSource, *basecrm.Response, error)
+	EditFunc           func(id int, dealSource *basecrm.DealSource) (*basecrm.DealSource, *basecrm.Response, error)
+	EditContextFunc    func(ctx context.Context, id int, dealSource *basecrm.DealSource) (*basecrm.DealSource, *basecrm.Response, error)
+	DeleteFunc         func(id int) (bool, *basecrm.Response, error)
+	DeleteContextFunc  func(ctx context.Context, id int) (bool, *basecrm.Response, error)
+}
+
+var _ basecrm.DealSourcesService = (*DealSourcesServiceMock)(nil)
+
+func (m *DealSourcesServiceMock) List(opt *basecrm.DealSourceListOptions) ([]*basecrm.DealSource, *basecrm.Response, error) {
+	m.record("List", opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(opt)
+	}
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(context.Background(), opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *DealSourcesServiceMock) ListContext(ctx context.Context, opt *basecrm.DealSourceListOptions) ([]*basecrm.DealSource, *basecrm.Response, error) {
+	m.record("ListContext", opt)
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(ctx, opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *DealSourcesServiceMock) ListAll(opt *basecrm.DealSourceListOptions) *basecrm.Iter[*basecrm.DealSource] {
+	m.record("ListAll", opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(opt)
+	}
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(context.Background(), opt)
+	}
+	return IterOf[*basecrm.DealSource]()
+}
+
+func (m *DealSourcesServiceMock) ListAllContext(ctx context.Context, opt *basecrm.DealSourceListOptions) *basecrm.Iter[*basecrm.DealSource] {
+	m.record("ListAllContext", opt)
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(ctx, opt)
+	}
+	return IterOf[*basecrm.DealSource]()
+}
+
+func (m *DealSourcesServiceMock) Get(id int) (*basecrm.DealSource, *basecrm.Response, error) {
+	m.record("Get", id)
+	if m.GetFunc != nil {
+		return m.GetFunc(id)
+	}
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(context.Background(), id)
+	}
+	return nil, nil, nil
+}
+
+func (m *DealSourcesServiceMock) GetContext(ctx context.Context, id int) (*basecrm.DealSource, *basecrm.Response, error) {
+	m.record("GetContext", id)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, id)
+	}
+	return nil, nil, nil
+}
+
+func (m *DealSourcesServiceMock) Create(dealSource *basecrm.DealSource) (*basecrm.DealSource, *basecrm.Response, error) {
+	m.record("Create", dealSource)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(dealSource)
+	}
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(context.Background(), dealSource)
+	}
+	return nil, nil, nil
+}
+
+func (m *DealSourcesServiceMock) CreateContext(ctx context.Context, dealSource *basecrm.DealSource) (*basecrm.DealSource, *basecrm.Response, error) {
+	m.record("CreateContext", dealSource)
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(ctx, dealSource)
+	}
+	return nil, nil, nil
+}
+
+func (m *DealSourcesServiceMock) Edit(id int, dealSource *basecrm.DealSource) (*basecrm.DealSource, *basecrm.Response, error) {
+	m.record("Edit", id, dealSource)
+	if m.EditFunc != nil {
+		return m.EditFunc(id, dealSource)
+	}
+	if m.EditContextFunc != nil {
+		return m.EditContextFunc(context.Background(), id, dealSource)
+	}
+	return nil, nil, nil
+}
+
+func (m *DealSourcesServiceMock) EditContext(ctx context.Context, id int, dealSource *basecrm.DealSource) (*basecrm.DealSource, *basecrm.Response, error) {
+	m.record("EditContext", id, dealSource)
+	if m.EditContextFunc != nil {
+		return m.EditContextFunc(ctx, id, dealSource)
+	}
+	return nil, nil, nil
+}
+
+func (m *DealSourcesServiceMock) Delete(id int) (bool, *basecrm.Response, error) {
+	m.record("Delete", id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(id)
+	}
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(context.Background(), id)
+	}
+	return false, nil, nil
+}
+
+func (m *DealSourcesServiceMock) DeleteContext(ctx context.Context, id int) (bool, *basecrm.Response, error) {
+	m.record("DeleteContext", id)
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(ctx, id)
+	}
+	return false, nil, nil
+}
+
+// DealUnqualifiedReasonsServiceMock is a mock of basecrm.DealUnqualifiedReasonsService.
+// Calls are recorded, and delegated to the function of the method when set.
+type DealUnqualifiedReasonsServiceMock struct {
+	Recorder
+
+	ListFunc           func(opt *basecrm.DealUnqualifiedReasonListOptions) ([]*basecrm.DealUnqualifiedReason, *basecrm.Response, error)
+	ListContextFunc    func(ctx context.Context, opt *basecrm.DealUnqualifiedReasonListOptions) ([]*basecrm.DealUnqualifiedReason, *basecrm.Response, error)
+	ListAllFunc        func(opt *basecrm.DealUnqualifiedReasonListOptions) *basecrm.Iter[*basecrm.DealUnqualifiedReason]
+	ListAllContextFunc func(ctx context.Context, opt *basecrm.DealUnqualifiedReasonListOptions) *basecrm.Iter[*basecrm.DealUnqualifiedReason]
+	GetFunc            func(id int) (*basecrm.DealUnqualifiedReason, *basecrm.Response, error)
+	GetContextFunc     func(ctx context.Context, id int) (*basecrm.DealUnqualifiedReason, *basecrm.Response, error)
+	CreateFunc         func(dealUnqualifiedReason *basecrm.DealUnqualifiedReason) (*basecrm.DealUnqualifiedReason, *basecrm.Response, error)
+	CreateContextFunc  func(ctx context.Context, dealUnqualifiedReason *basecrm.DealUnqualifiedReason) (*basecrm.DealUnqualifiedReason, *basecrm.Response, error)
+	EditFunc           func(id int, dealUnqualifiedReason *basecrm.DealUnqualifiedReason) (*basecrm.DealUnqualifiedReason, *basecrm.Response, error)
+	EditContextFunc    func(ctx context.Context, id int, dealUnqualifiedReason *basecrm.DealUnqualifiedReason) (*basecrm.DealUnqualifiedReason, *basecrm.Response, error)
+	DeleteFunc         func(id int) (bool, *basecrm.Response, error)
+	DeleteContextFunc  func(ctx context.Context, id int) (bool, *basecrm.Response, error)
+}
+
+var _ basecrm.DealUnqualifiedReasonsService = (*DealUnqualifiedReasonsServiceMock)(nil)
+
+func (m *DealUnqualifiedReasonsServiceMock) List(opt *basecrm.DealUnqualifiedReasonListOptions) ([]*basecrm.DealUnqualifiedReason, *basecrm.Response, error) {
+	m.record("List", opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(opt)
+	}
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(context.Background(), opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *DealUnqualifiedReasonsServiceMock) ListContext(ctx context.Context, opt *basecrm.DealUnqualifiedReasonListOptions) ([]*basecrm.DealUnqualifiedReason, *basecrm.Response, error) {
+	m.record("ListContext", opt)
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(ctx, opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *DealUnqualifiedReasonsServiceMock) ListAll(opt *basecrm.DealUnqualifiedReasonListOptions) *basecrm.Iter[*basecrm.DealUnqualifiedReason] {
+	m.record("ListAll", opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(opt)
+	}
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(context.Background(), opt)
+	}
+	return IterOf[*basecrm.DealUnqualifiedReason]()
+}
+
+func (m *DealUnqualifiedReasonsServiceMock) ListAllContext(ctx context.Context, opt *basecrm.DealUnqualifiedReasonListOptions) *basecrm.Iter[*basecrm.DealUnqualifiedReason] {
+	m.record("ListAllContext", opt)
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(ctx, opt)
+	}
+	return IterOf[*basecrm.DealUnqualifiedReason]()
+}
+
+func (m *DealUnqualifiedReasonsServiceMock) Get(id int) (*basecrm.DealUnqualifiedReason, *basecrm.Response, error) {
+	m.record("Get", id)
+	if m.GetFunc != nil {
+		return m.GetFunc(id)
+	}
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(context.Background(), id)
+	}
+	return nil, nil, nil
+}
+
+func (m *DealUnqualifiedReasonsServiceMock) GetContext(ctx context.Context, id int) (*basecrm.DealUnqualifiedReason, *basecrm.Response, error) {
+	m.record("GetContext", id)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, id)
+	}
+	return nil, nil, nil
+}
+
+func (m *DealUnqualifiedReasonsServiceMock) Create(dealUnqualifiedReason *basecrm.DealUnqualifiedReason) (*basecrm.DealUnqualifiedReason, *basecrm.Response, error) {
+	m.record("Create", dealUnqualifiedReason)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(dealUnqualifiedReason)
+	}
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(context.Background(), dealUnqualifiedReason)
+	}
+	return nil, nil, nil
+}
+
+func (m *DealUnqualifiedReasonsServiceMock) CreateContext(ctx context.Context, dealUnqualifiedReason *basecrm.DealUnqualifiedReason) (*basecrm.DealUnqualifiedReason, *basecrm.Response, error) {
+	m.record("CreateContext", dealUnqualifiedReason)
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(ctx, dealUnqualifiedReason)
+	}
+	return nil, nil, nil
+}
+
+func (m *DealUnqualifiedReasonsServiceMock) Edit(id int, dealUnqualifiedReason *basecrm.DealUnqualifiedReason) (*basecrm.DealUnqualifiedReason, *basecrm.Response, error) {
+	m.record("Edit", id, dealUnqualifiedReason)
+	if m.EditFunc != nil {
+		return m.EditFunc(id, dealUnqualifiedReason)
+	}
+	if m.EditContextFunc != nil {
+		return m.EditContextFunc(context.Background(), id, dealUnqualifiedReason)
+	}
+	return nil, nil, nil
+}
+
+func (m *DealUnqualifiedReasonsServiceMock) EditContext(ctx context.Context, id int, dealUnqualifiedReason *basecrm.DealUnqualifiedReason) (*basecrm.DealUnqualifiedReason, *basecrm.Response, error) {
+	m.record("EditContext", id, dealUnqualifiedReason)
+	if m.EditContextFunc != nil {
+		return m.EditContextFunc(ctx, id, dealUnqualifiedReason)
+	}
+	return nil, nil, nil
+}
+
+func (m *DealUnqualifiedReasonsServiceMock) Delete(id int) (bool, *basecrm.Response, error) {
+	m.record("Delete", id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(id)
+	}
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(context.Background(), id)
+	}
+	return false, nil, nil
+}
+
+func (m *DealUnqualifiedReasonsServiceMock) DeleteContext(ctx context.Context, id int) (bool, *basecrm.Response, error) {
+	m.record("DeleteContext", id)
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(ctx, id)
+	}
+	return false, nil, nil
+}
+
+// DealsServiceMock is a mock of basecrm.DealsService.
+// Calls are recorded, and delegated to the function of the method when set.
+type DealsServiceMock struct {
+	Recorder
+
+	ListFunc                   func(opt *basecrm.DealListOptions) ([]*basecrm.Deal, *basecrm.Response, error)
+	ListContextFunc            func(ctx context.Context, opt *basecrm.DealListOptions) ([]*basecrm.Deal, *basecrm.Response, error)
+	ListAllFunc                func(opt *basecrm.DealListOptions) *basecrm.Iter[*basecrm.Deal]
+	ListAllContextFunc         func(ctx context.Context, opt *basecrm.DealListOptions) *basecrm.Iter[*basecrm.Deal]
+	GetFunc                    func(id int) (*basecrm.Deal, *basecrm.Response, error)
+	GetContextFunc             func(ctx context.Context, id int) (*basecrm.Deal, *basecrm.Response, error)
+	CreateFunc                 func(deal *basecrm.Deal) (*basecrm.Deal, *basecrm.Response, error)
+	CreateContextFunc          func(ctx context.Context, deal *basecrm.Deal) (*basecrm.Deal, *basecrm.Response, error)
+	EditFunc                   func(id int, deal *basecrm.Deal) (*basecrm.Deal, *basecrm.Response, error)
+	EditContextFunc            func(ctx context.Context, id int, deal *basecrm.Deal) (*basecrm.Deal, *basecrm.Response, error)
+	DeleteFunc                 func(id int) (bool, *basecrm.Response, error)
+	DeleteContextFunc          func(ctx context.Context, id int) (bool, *basecrm.Response, error)
+	ListContactsFunc           func(id int, opt *basecrm.AssociatedContactListOptions) ([]*basecrm.AssociatedContact, *basecrm.Response, error)
+	ListContactsContextFunc    func(ctx context.Context, id int, opt *basecrm.AssociatedContactListOptions) ([]*basecrm.AssociatedContact, *basecrm.Response, error)
+	ListAllContactsFunc        func(id int, opt *basecrm.AssociatedContactListOptions) *basecrm.Iter[*basecrm.AssociatedContact]
+	ListAllContactsContextFunc func(ctx context.Context, id int, opt *basecrm.AssociatedContactListOptions) *basecrm.Iter[*basecrm.AssociatedContact]
+	UpsertContactFunc          func(id int, contact *basecrm.AssociatedContact) (bool, *basecrm.Response, error)
+	UpsertContactContextFunc   func(ctx context.Context, id int, contact *basecrm.AssociatedContact) (bool, *basecrm.Response, error)
+	DeleteContactFunc          func(id int, contactId int) (bool, *basecrm.Response, error)
+	DeleteContactContextFunc   func(ctx context.Context, id int, contactId int) (bool, *basecrm.Response, error)
+}
+
+var _ basecrm.DealsService = (*DealsServiceMock)(nil)
+
+func (m *DealsServiceMock) List(opt *basecrm.DealListOptions) ([]*basecrm.Deal, *basecrm.Response, error) {
+	m.record("List", opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(opt)
+	}
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(context.Background(), opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *DealsServiceMock) ListContext(ctx context.Context, opt *basecrm.DealListOptions) ([]*basecrm.Deal, *basecrm.Response, error) {
+	m.record("ListContext", opt)
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(ctx, opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *DealsServiceMock) ListAll(opt *basecrm.DealListOptions) *basecrm.Iter[*basecrm.Deal] {
+	m.record("ListAll", opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(opt)
+	}
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(context.Background(), opt)
+	}
+	return IterOf[*basecrm.Deal]()
+}
+
+func (m *DealsServiceMock) ListAllContext(ctx context.Context, opt *basecrm.DealListOptions) *basecrm.Iter[*basecrm.Deal] {
+	m.record("ListAllContext", opt)
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(ctx, opt)
+	}
+	return IterOf[*basecrm.Deal]()
+}
+
+func (m *DealsServiceMock) Get(id int) (*basecrm.Deal, *basecrm.Response, error) {
+	m.record("Get", id)
+	if m.GetFunc != nil {
+		return m.GetFunc(id)
+	}
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(context.Background(), id)
+	}
+	return nil, nil, nil
+}
+
+func (m *DealsServiceMock) GetContext(ctx context.Context, id int) (*basecrm.Deal, *basecrm.Response, error) {
+	m.record("GetContext", id)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, id)
+	}
+	return nil, nil, nil
+}
+
+func (m *DealsServiceMock) Create(deal *basecrm.Deal) (*basecrm.Deal, *basecrm.Response, error) {
+	m.record("Create", deal)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(deal)
+	}
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(context.Background(), deal)
+	}
+	return nil, nil, nil
+}
+
+func (m *DealsServiceMock) CreateContext(ctx context.Context, deal *basecrm.Deal) (*basecrm.Deal, *basecrm.Response, error) {
+	m.record("CreateContext", deal)
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(ctx, deal)
+	}
+	return nil, nil, nil
+}
+
+func (m *DealsServiceMock) Edit(id int, deal *basecrm.Deal) (*basecrm.Deal, *basecrm.Response, error) {
+	m.record("Edit", id, deal)
+	if m.EditFunc != nil {
+		return m.EditFunc(id, deal)
+	}
+	if m.EditContextFunc != nil {
+		return m.EditContextFunc(context.Background(), id, deal)
+	}
+	return nil, nil, nil
+}
+
+func (m *DealsServiceMock) EditContext(ctx context.Context, id int, deal *basecrm.Deal) (*basecrm.Deal, *basecrm.Response, error) {
+	m.record("EditContext", id, deal)
+	if m.EditContextFunc != nil {
+		return m.EditContextFunc(ctx, id, deal)
+	}
+	return nil, nil, nil
+}
+
+func (m *DealsServiceMock) Delete(id int) (bool, *basecrm.Response, error) {
+	m.record("Delete", id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(id)
+	}
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(context.Background(), id)
+	}
+	return false, nil, nil
+}
+
+func (m *DealsServiceMock) DeleteContext(ctx context.Context, id int) (bool, *basecrm.Response, error) {
+	m.record("DeleteContext", id)
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(ctx, id)
+	}
+	return false, nil, nil
+}
+
+func (m *DealsServiceMock) ListContacts(id int, opt *basecrm.AssociatedContactListOptions) ([]*basecrm.AssociatedContact, *basecrm.Response, error) {
+	m.record("ListContacts", id, opt)
+	if m.ListContactsFunc != nil {
+		return m.ListContactsFunc(id, opt)
+	}
+	if m.ListContactsContextFunc != nil {
+		return m.ListContactsContextFunc(context.Background(), id, opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *DealsServiceMock) ListContactsContext(ctx context.Context, id int, opt *basecrm.AssociatedContactListOptions) ([]*basecrm.AssociatedContact, *basecrm.Response, error) {
+	m.record("ListContactsContext", id, opt)
+	if m.ListContactsContextFunc != nil {
+		return m.ListContactsContextFunc(ctx, id, opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *DealsServiceMock) ListAllContacts(id int, opt *basecrm.AssociatedContactListOptions) *basecrm.Iter[*basecrm.AssociatedContact] {
+	m.record("ListAllContacts", id, opt)
+	if m.ListAllContactsFunc != nil {
+		return m.ListAllContactsFunc(id, opt)
+	}
+	if m.ListAllContactsContextFunc != nil {
+		return m.ListAllContactsContextFunc(context.Background(), id, opt)
+	}
+	return IterOf[*basecrm.AssociatedContact]()
+}
+
+func (m *DealsServiceMock) ListAllContactsContext(ctx context.Context, id int, opt *basecrm.AssociatedContactListOptions) *basecrm.Iter[*basecrm.AssociatedContact] {
+	m.record("ListAllContactsContext", id, opt)
+	if m.ListAllContactsContextFunc != nil {
+		return m.ListAllContactsContextFunc(ctx, id, opt)
+	}
+	return IterOf[*basecrm.AssociatedContact]()
+}
+
+func (m *DealsServiceMock) UpsertContact(id int, contact *basecrm.AssociatedContact) (bool, *basecrm.Response, error) {
+	m.record("UpsertContact", id, contact)
+	if m.UpsertContactFunc != nil {
+		return m.UpsertContactFunc(id, contact)
+	}
+	if m.UpsertContactContextFunc != nil {
+		return m.UpsertContactContextFunc(context.Background(), id, contact)
+	}
+	return false, nil, nil
+}
+
+func (m *DealsServiceMock) UpsertContactContext(ctx context.Context, id int, contact *basecrm.AssociatedContact) (bool, *basecrm.Response, error) {
+	m.record("UpsertContactContext", id, contact)
+	if m.UpsertContactContextFunc != nil {
+		return m.UpsertContactContextFunc(ctx, id, contact)
+	}
+	return false, nil, nil
+}
+
+func (m *DealsServiceMock) DeleteContact(id int, contactId int) (bool, *basecrm.Response, error) {
+	m.record("DeleteContact", id, contactId)
+	if m.DeleteContactFunc != nil {
+		return m.DeleteContactFunc(id, contactId)
+	}
+	if m.DeleteContactContextFunc != nil {
+		return m.DeleteContactContextFunc(context.Background(), id, contactId)
+	}
+	return false, nil, nil
+}
+
+func (m *DealsServiceMock) DeleteContactContext(ctx context.Context, id int, contactId int) (bool, *basecrm.Response, error) {
+	m.record("DeleteContactContext", id, contactId)
+	if m.DeleteContactContextFunc != nil {
+		return m.DeleteContactContextFunc(ctx, id, contactId)
+	}
+	return false, nil, nil
+}
+
+// FirehoseServiceMock is a mock of basecrm.FirehoseService.
+// Calls are recorded, and delegated to the function of the method when set.
+type FirehoseServiceMock struct {
+	Recorder
+
+	StreamFunc        func(resource string, position string) (*basecrm.FirehosePage, *basecrm.Response, error)
+	StreamContextFunc func(ctx context.Context, resource string, position string) (*basecrm.FirehosePage, *basecrm.Response, error)
+}
+
+var _ basecrm.FirehoseService = (*FirehoseServiceMock)(nil)
+
+func (m *FirehoseServiceMock) Stream(resource string, position string) (*basecrm.FirehosePage, *basecrm.Response, error) {
+	m.record("Stream", resource, position)
+	if m.StreamFunc != nil {
+		return m.StreamFunc(resource, position)
+	}
+	if m.StreamContextFunc != nil {
+		return m.StreamContextFunc(context.Background(), resource, position)
+	}
+	return nil, nil, nil
+}
+
+func (m *FirehoseServiceMock) StreamContext(ctx context.Context, resource string, position string) (*basecrm.FirehosePage, *basecrm.Response, error) {
+	m.record("StreamContext", resource, position)
+	if m.StreamContextFunc != nil {
+		return m.StreamContextFunc(ctx, resource, position)
+	}
+	return nil, nil, nil
+}
+
+// LeadConversionsServiceMock is a mock of basecrm.LeadConversionsService.
+// Calls are recorded, and delegated to the function of the method when set.
+type LeadConversionsServiceMock struct {
+	Recorder
+
+	ListFunc           func(opt *basecrm.LeadConversionListOptions) ([]*basecrm.LeadConversion, *basecrm.Response, error)
+	ListContextFunc    func(ctx context.Context, opt *basecrm.LeadConversionListOptions) ([]*basecrm.LeadConversion, *basecrm.Response, error)
+	ListAllFunc        func(opt *basecrm.LeadConversionListOptions) *basecrm.Iter[*basecrm.LeadConversion]
+	ListAllContextFunc func(ctx context.Context, opt *basecrm.LeadConversionListOptions) *basecrm.Iter[*basecrm.LeadConversion]
+	ConvertFunc        func(leadId int) (*basecrm.LeadConversion, *basecrm.Response, error)
+	ConvertContextFunc func(ctx context.Context, leadId int) (*basecrm.LeadConversion, *basecrm.Response, error)
+}
+
+var _ basecrm.LeadConversionsService = (*LeadConversionsServiceMock)(nil)
+
+func (m *LeadConversionsServiceMock) List(opt *basecrm.LeadConversionListOptions) ([]*basecrm.LeadConversion, *basecrm.Response, error) {
+	m.record("List", opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(opt)
+	}
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(context.Background(), opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *LeadConversionsServiceMock) ListContext(ctx context.Context, opt *basecrm.LeadConversionListOptions) ([]*basecrm.LeadConversion, *basecrm.Response, error) {
+	m.record("ListContext", opt)
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(ctx, opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *LeadConversionsServiceMock) ListAll(opt *basecrm.LeadConversionListOptions) *basecrm.Iter[*basecrm.LeadConversion] {
+	m.record("ListAll", opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(opt)
+	}
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(context.Background(), opt)
+	}
+	return IterOf[*basecrm.LeadConversion]()
+}
+
+func (m *LeadConversionsServiceMock) ListAllContext(ctx context.Context, opt *basecrm.LeadConversionListOptions) *basecrm.Iter[*basecrm.LeadConversion] {
+	m.record("ListAllContext", opt)
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(ctx, opt)
+	}
+	return IterOf[*basecrm.LeadConversion]()
+}
+
+func (m *LeadConversionsServiceMock) Convert(leadId int) (*basecrm.LeadConversion, *basecrm.Response, error) {
+	m.record("Convert", leadId)
+	if m.ConvertFunc != nil {
+		return m.ConvertFunc(leadId)
+	}
+	if m.ConvertContextFunc != nil {
+		return m.ConvertContextFunc(context.Background(), leadId)
+	}
+	return nil, nil, nil
+}
+
+func (m *LeadConversionsServiceMock) ConvertContext(ctx context.Context, leadId int) (*basecrm.LeadConversion, *basecrm.Response, error) {
+	m.record("ConvertContext", leadId)
+	if m.ConvertContextFunc != nil {
+		return m.ConvertContextFunc(ctx, leadId)
+	}
+	return nil, nil, nil
+}
+
+// LeadSourcesServiceMock is a mock of basecrm.LeadSourcesService.
+// Calls are recorded, and delegated to the function of the method when set.
+type LeadSourcesServiceMock struct {
+	Recorder
+
+	ListFunc           func(opt *basecrm.LeadSourceListOptions) ([]*basecrm.LeadSource, *basecrm.Response, error)
+	ListContextFunc    func(ctx context.Context, opt *basecrm.LeadSourceListOptions) ([]*basecrm.LeadSource, *basecrm.Response, error)
+	ListAllFunc        func(opt *basecrm.LeadSourceListOptions) *basecrm.Iter[*basecrm.LeadSource]
+	ListAllContextFunc func(ctx context.Context, opt *basecrm.LeadSourceListOptions) *basecrm.Iter[*basecrm.LeadSource]
+	GetFunc            func(id int) (*basecrm.LeadSource, *basecrm.Response, error)
+	GetContextFunc     func(ctx context.Context, id int) (*basecrm.LeadSource, *basecrm.Response, error)
+	CreateFunc         func(leadSource *basecrm.LeadSource) (*basecrm.LeadSource, *basecrm.Response, error)
+	CreateContextFunc  func(ctx context.Context, leadSource *basecrm.LeadSource) (*basecrm.LeadSource, *basecrm.Response, error)
+	EditFunc           func(id int, leadSource *basecrm.LeadSource) (*basecrm.LeadSource, *basecrm.Response, error)
+	EditContextFunc    func(ctx context.Context, id int, leadSource *basecrm.LeadSource) (*basecrm.LeadSource, *basecrm.Response, error)
+	DeleteFunc         func(id int) (bool, *basecrm.Response, error)
+	DeleteContextFunc  func(ctx context.Context, id int) (bool, *basecrm.Response, error)
+}
+
+var _ basecrm.LeadSourcesService = (*LeadSourcesServiceMock)(nil)
+
+func (m *LeadSourcesServiceMock) List(opt *basecrm.LeadSourceListOptions) ([]*basecrm.LeadSource, *basecrm.Response, error) {
+	m.record("List", opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(opt)
+	}
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(context.Background(), opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *LeadSourcesServiceMock) ListContext(ctx context.Context, opt *basecrm.LeadSourceListOptions) ([]*basecrm.LeadSource, *basecrm.Response, error) {
+	m.record("ListContext", opt)
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(ctx, opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *LeadSourcesServiceMock) ListAll(opt *basecrm.LeadSourceListOptions) *basecrm.Iter[*basecrm.LeadSource] {
+	m.record("ListAll", opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(opt)
+	}
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(context.Background(), opt)
+	}
+	return IterOf[*basecrm.LeadSource]()
+}
+
+func (m *LeadSourcesServiceMock) ListAllContext(ctx context.Context, opt *basecrm.LeadSourceListOptions) *basecrm.Iter[*basecrm.LeadSource] {
+	m.record("ListAllContext", opt)
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(ctx, opt)
+	}
+	return IterOf[*basecrm.LeadSource]()
+}
+
+func (m *LeadSourcesServiceMock) Get(id int) (*basecrm.LeadSource, *basecrm.Response, error) {
+	m.record("Get", id)
+	if m.GetFunc != nil {
+		return m.GetFunc(id)
+	}
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(context.Background(), id)
+	}
+	return nil, nil, nil
+}
+
+func (m *LeadSourcesServiceMock) GetContext(ctx context.Context, id int) (*basecrm.LeadSource, *basecrm.Response, error) {
+	m.record("GetContext", id)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, id)
+	}
+	return nil, nil, nil
+}
+
+func (m *LeadSourcesServiceMock) Create(leadSource *basecrm.LeadSource) (*basecrm.LeadSource, *basecrm.Response, error) {
+	m.record("Create", leadSource)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(leadSource)
+	}
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(context.Background(), leadSource)
+	}
+	return nil, nil, nil
+}
+
+func (m *LeadSourcesServiceMock) CreateContext(ctx context.Context, leadSource *basecrm.LeadSource) (*basecrm.LeadSource, *basecrm.Response, error) {
+	m.record("CreateContext", leadSource)
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(ctx, leadSource)
+	}
+	return nil, nil, nil
+}
+
+func (m *LeadSourcesServiceMock) Edit(id int, leadSource *basecrm.LeadSource) (*basecrm.LeadSource, *basecrm.Response, error) {
+	m.record("Edit", id, leadSource)
+	if m.EditFunc != nil {
+		return m.EditFunc(id, leadSource)
+	}
+	if m.EditContextFunc != nil {
+		return m.EditContextFunc(context.Background(), id, leadSource)
+	}
+	return nil, nil, nil
+}
+
+func (m *LeadSourcesServiceMock) EditContext(ctx context.Context, id int, leadSource *basecrm.LeadSource) (*basecrm.LeadSource, *basecrm.Response, error) {
+	m.record("EditContext", id, leadSource)
+	if m.EditContextFunc != nil {
+		return m.EditContextFunc(ctx, id, leadSource)
+	}
+	return nil, nil, nil
+}
+
+func (m *LeadSourcesServiceMock) Delete(id int) (bool, *basecrm.Response, error) {
+	m.record("Delete", id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(id)
+	}
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(context.Background(), id)
+	}
+	return false, nil, nil
+}
+
+func (m *LeadSourcesServiceMock) DeleteContext(ctx context.Context, id int) (bool, *basecrm.Response, error) {
+	m.record("DeleteContext", id)
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(ctx, id)
+	}
+	return false, nil, nil
+}
+
+// LeadUnqualifiedReasonsServiceMock is a mock of basecrm.LeadUnqualifiedReasonsService.
+// Calls are recorded, and delegated to the function of the method when set.
+type LeadUnqualifiedReasonsServiceMock struct {
+	Recorder
+
+	ListFunc           func(opt *basecrm.LeadUnqualifiedReasonListOptions) ([]*basecrm.LeadUnqualifiedReason, *basecrm.Response, error)
+	ListContextFunc    func(ctx context.Context, opt *basecrm.LeadUnqualifiedReasonListOptions) ([]*basecrm.LeadUnqualifiedReason, *basecrm.Response, error)
+	ListAllFunc        func(opt *basecrm.LeadUnqualifiedReasonListOptions) *basecrm.Iter[*basecrm.LeadUnqualifiedReason]
+	ListAllContextFunc func(ctx context.Context, opt *basecrm.LeadUnqualifiedReasonListOptions) *basecrm.Iter[*basecrm.LeadUnqualifiedReason]
+	GetFunc            func(id int) (*basecrm.LeadUnqualifiedReason, *basecrm.Response, error)
+	GetContextFunc     func(ctx context.Context, id int) (*basecrm.LeadUnqualifiedReason, *basecrm.Response, error)
+	CreateFunc         func(leadUnqualifiedReason *basecrm.LeadUnqualifiedReason) (*basecrm.LeadUnqualifiedReason, *basecrm.Response, error)
+	CreateContextFunc  func(ctx context.Context, leadUnqualifiedReason *basecrm.LeadUnqualifiedReason) (*basecrm.LeadUnqualifiedReason, *basecrm.Response, error)
+	EditFunc           func(id int, leadUnqualifiedReason *basecrm.LeadUnqualifiedReason) (*basecrm.LeadUnqualifiedReason, *basecrm.Response, error)
+	EditContextFunc    func(ctx context.Context, id int, leadUnqualifiedReason *basecrm.LeadUnqualifiedReason) (*basecrm.LeadUnqualifiedReason, *basecrm.Response, error)
+	DeleteFunc         func(id int) (bool, *basecrm.Response, error)
+	DeleteContextFunc  func(ctx context.Context, id int) (bool, *basecrm.Response, error)
+}
+
+var _ basecrm.LeadUnqualifiedReasonsService = (*LeadUnqualifiedReasonsServiceMock)(nil)
+
+func (m *LeadUnqualifiedReasonsServiceMock) List(opt *basecrm.LeadUnqualifiedReasonListOptions) ([]*basecrm.LeadUnqualifiedReason, *basecrm.Response, error) {
+	m.record("List", opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(opt)
+	}
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(context.Background(), opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *LeadUnqualifiedReasonsServiceMock) ListContext(ctx context.Context, opt *basecrm.LeadUnqualifiedReasonListOptions) ([]*basecrm.LeadUnqualifiedReason, *basecrm.Response, error) {
+	m.record("ListContext", opt)
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(ctx, opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *LeadUnqualifiedReasonsServiceMock) ListAll(opt *basecrm.LeadUnqualifiedReasonListOptions) *basecrm.Iter[*basecrm.LeadUnqualifiedReason] {
+	m.record("ListAll", opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(opt)
+	}
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(context.Background(), opt)
+	}
+	return IterOf[*basecrm.LeadUnqualifiedReason]()
+}
+
+func (m *LeadUnqualifiedReasonsServiceMock) ListAllContext(ctx context.Context, opt *basecrm.LeadUnqualifiedReasonListOptions) *basecrm.Iter[*basecrm.LeadUnqualifiedReason] {
+	m.record("ListAllContext", opt)
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(ctx, opt)
+	}
+	return IterOf[*basecrm.LeadUnqualifiedReason]()
+}
+
+func (m *LeadUnqualifiedReasonsServiceMock) Get(id int) (*basecrm.LeadUnqualifiedReason, *basecrm.Response, error) {
+	m.record("Get", id)
+	if m.GetFunc != nil {
+		return m.GetFunc(id)
+	}
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(context.Background(), id)
+	}
+	return nil, nil, nil
+}
+
+func (m *LeadUnqualifiedReasonsServiceMock) GetContext(ctx context.Context, id int) (*basecrm.LeadUnqualifiedReason, *basecrm.Response, error) {
+	m.record("GetContext", id)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, id)
+	}
+	return nil, nil, nil
+}
+
+func (m *LeadUnqualifiedReasonsServiceMock) Create(leadUnqualifiedReason *basecrm.LeadUnqualifiedReason) (*basecrm.LeadUnqualifiedReason, *basecrm.Response, error) {
+	m.record("Create", leadUnqualifiedReason)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(leadUnqualifiedReason)
+	}
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(context.Background(), leadUnqualifiedReason)
+	}
+	return nil, nil, nil
+}
+
+func (m *LeadUnqualifiedReasonsServiceMock) CreateContext(ctx context.Context, leadUnqualifiedReason *basecrm.LeadUnqualifiedReason) (*basecrm.LeadUnqualifiedReason, *basecrm.Response, error) {
+	m.record("CreateContext", leadUnqualifiedReason)
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(ctx, leadUnqualifiedReason)
+	}
+	return nil, nil, nil
+}
+
+func (m *LeadUnqualifiedReasonsServiceMock) Edit(id int, leadUnqualifiedReason *basecrm.LeadUnqualifiedReason) (*basecrm.LeadUnqualifiedReason, *basecrm.Response, error) {
+	m.record("Edit", id, leadUnqualifiedReason)
+	if m.EditFunc != nil {
+		return m.EditFunc(id, leadUnqualifiedReason)
+	}
+	if m.EditContextFunc != nil {
+		return m.EditContextFunc(context.Background(), id, leadUnqualifiedReason)
+	}
+	return nil, nil, nil
+}
+
+func (m *LeadUnqualifiedReasonsServiceMock) EditContext(ctx context.Context, id int, leadUnqualifiedReason *basecrm.LeadUnqualifiedReason) (*basecrm.LeadUnqualifiedReason, *basecrm.Response, error) {
+	m.record("EditContext", id, leadUnqualifiedReason)
+	if m.EditContextFunc != nil {
+		return m.EditContextFunc(ctx, id, leadUnqualifiedReason)
+	}
+	return nil, nil, nil
+}
+
+func (m *LeadUnqualifiedReasonsServiceMock) Delete(id int) (bool, *basecrm.Response, error) {
+	m.record("Delete", id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(id)
+	}
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(context.Background(), id)
+	}
+	return false, nil, nil
+}
+
+func (m *LeadUnqualifiedReasonsServiceMock) DeleteContext(ctx context.Context, id int) (bool, *basecrm.Response, error) {
+	m.record("DeleteContext", id)
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(ctx, id)
+	}
+	return false, nil, nil
+}
+
+// LeadsServiceMock is a mock of basecrm.LeadsService.
+// Calls are recorded, and delegated to the function of the method when set.
+type LeadsServiceMock struct {
+	Recorder
+
+	ListFunc           func(opt *basecrm.LeadListOptions) ([]*basecrm.Lead, *basecrm.Response, error)
+	ListContextFunc    func(ctx context.Context, opt *basecrm.LeadListOptions) ([]*basecrm.Lead, *basecrm.Response, error)
+	ListAllFunc        func(opt *basecrm.LeadListOptions) *basecrm.Iter[*basecrm.Lead]
+	ListAllContextFunc func(ctx context.Context, opt *basecrm.LeadListOptions) *basecrm.Iter[*basecrm.Lead]
+	GetFunc            func(id int) (*basecrm.Lead, *basecrm.Response, error)
+	GetContextFunc     func(ctx context.Context, id int) (*basecrm.Lead, *basecrm.Response, error)
+	CreateFunc         func(lead *basecrm.Lead) (*basecrm.Lead, *basecrm.Response, error)
+	CreateContextFunc  func(ctx context.Context, lead *basecrm.Lead) (*basecrm.Lead, *basecrm.Response, error)
+	EditFunc           func(id int, lead *basecrm.Lead) (*basecrm.Lead, *basecrm.Response, error)
+	EditContextFunc    func(ctx context.Context, id int, lead *basecrm.Lead) (*basecrm.Lead, *basecrm.Response, error)
+	DeleteFunc         func(id int) (bool, *basecrm.Response, error)
+	DeleteContextFunc  func(ctx context.Context, id int) (bool, *basecrm.Response, error)
+}
+
+var _ basecrm.LeadsService = (*LeadsServiceMock)(nil)
+
+func (m *LeadsServiceMock) List(opt *basecrm.LeadListOptions) ([]*basecrm.Lead, *basecrm.Response, error) {
+	m.record("List", opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(opt)
+	}
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(context.Background(), opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *LeadsServiceMock) ListContext(ctx context.Context, opt *basecrm.LeadListOptions) ([]*basecrm.Lead, *basecrm.Response, error) {
+	m.record("ListContext", opt)
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(ctx, opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *LeadsServiceMock) ListAll(opt *basecrm.LeadListOptions) *basecrm.Iter[*basecrm.Lead] {
+	m.record("ListAll", opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(opt)
+	}
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(context.Background(), opt)
+	}
+	return IterOf[*basecrm.Lead]()
+}
+
+func (m *LeadsServiceMock) ListAllContext(ctx context.Context, opt *basecrm.LeadListOptions) *basecrm.Iter[*basecrm.Lead] {
+	m.record("ListAllContext", opt)
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(ctx, opt)
+	}
+	return IterOf[*basecrm.Lead]()
+}
+
+func (m *LeadsServiceMock) Get(id int) (*basecrm.Lead, *basecrm.Response, error) {
+	m.record("Get", id)
+	if m.GetFunc != nil {
+		return m.GetFunc(id)
+	}
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(context.Background(), id)
+	}
+	return nil, nil, nil
+}
+
+func (m *LeadsServiceMock) GetContext(ctx context.Context, id int) (*basecrm.Lead, *basecrm.Response, error) {
+	m.record("GetContext", id)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, id)
+	}
+	return nil, nil, nil
+}
+
+func (m *LeadsServiceMock) Create(lead *basecrm.Lead) (*basecrm.Lead, *basecrm.Response, error) {
+	m.record("Create", lead)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(lead)
+	}
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(context.Background(), lead)
+	}
+	return nil, nil, nil
+}
+
+func (m *LeadsServiceMock) CreateContext(ctx context.Context, lead *basecrm.Lead) (*basecrm.Lead, *basecrm.Response, error) {
+	m.record("CreateContext", lead)
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(ctx, lead)
+	}
+	return nil, nil, nil
+}
+
+func (m *LeadsServiceMock) Edit(id int, lead *basecrm.Lead) (*basecrm.Lead, *basecrm.Response, error) {
+	m.record("Edit", id, lead)
+	if m.EditFunc != nil {
+		return m.EditFunc(id, lead)
+	}
+	if m.EditContextFunc != nil {
+		return m.EditContextFunc(context.Background(), id, lead)
+	}
+	return nil, nil, nil
+}
+
+func (m *LeadsServiceMock) EditContext(ctx context.Context, id int, lead *basecrm.Lead) (*basecrm.Lead, *basecrm.Response, error) {
+	m.record("EditContext", id, lead)
+	if m.EditContextFunc != nil {
+		return m.EditContextFunc(ctx, id, lead)
+	}
+	return nil, nil, nil
+}
+
+func (m *LeadsServiceMock) Delete(id int) (bool, *basecrm.Response, error) {
+	m.record("Delete", id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(id)
+	}
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(context.Background(), id)
+	}
+	return false, nil, nil
+}
+
+func (m *LeadsServiceMock) DeleteContext(ctx context.Context, id int) (bool, *basecrm.Response, error) {
+	m.record("DeleteContext", id)
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(ctx, id)
+	}
+	return false, nil, nil
+}
+
+// LineItemsServiceMock is a mock of basecrm.LineItemsService.
+// Calls are recorded, and delegated to the function of the method when set.
+type LineItemsServiceMock struct {
+	Recorder
+
+	ListFunc           func(orderId int, opt *basecrm.LineItemListOptions) ([]*basecrm.LineItem, *basecrm.Response, error)
+	ListContextFunc    func(ctx context.Context, orderId int, opt *basecrm.LineItemListOptions) ([]*basecrm.LineItem, *basecrm.Response, error)
+	ListAllFunc        func(orderId int, opt *basecrm.LineItemListOptions) *basecrm.Iter[*basecrm.LineItem]
+	ListAllContextFunc func(ctx context.Context, orderId int, opt *basecrm.LineItemListOptions) *basecrm.Iter[*basecrm.LineItem]
+	GetFunc            func(orderId int, id int) (*basecrm.LineItem, *basecrm.Response, error)
+	GetContextFunc     func(ctx context.Context, orderId int, id int) (*basecrm.LineItem, *basecrm.Response, error)
+	CreateFunc         func(orderId int, lineItem *basecrm.LineItem) (*basecrm.LineItem, *basecrm.Response, error)
+	CreateContextFunc  func(ctx context.Context, orderId int, lineItem *basecrm.LineItem) (*basecrm.LineItem, *basecrm.Response, error)
+	DeleteFunc         func(orderId int, id int) (bool, *basecrm.Response, error)
+	DeleteContextFunc  func(ctx context.Context, orderId int, id int) (bool, *basecrm.Response, error)
+}
+
+var _ basecrm.LineItemsService = (*LineItemsServiceMock)(nil)
+
+func (m *LineItemsServiceMock) List(orderId int, opt *basecrm.LineItemListOptions) ([]*basecrm.LineItem, *basecrm.Response, error) {
+	m.record("List", orderId, opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(orderId, opt)
+	}
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(context.Background(), orderId, opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *LineItemsServiceMock) ListContext(ctx context.Context, orderId int, opt *basecrm.LineItemListOptions) ([]*basecrm.LineItem, *basecrm.Response, error) {
+	m.record("ListContext", orderId, opt)
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(ctx, orderId, opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *LineItemsServiceMock) ListAll(orderId int, opt *basecrm.LineItemListOptions) *basecrm.Iter[*basecrm.LineItem] {
+	m.record("ListAll", orderId, opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(orderId, opt)
+	}
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(context.Background(), orderId, opt)
+	}
+	return IterOf[*basecrm.LineItem]()
+}
+
+func (m *LineItemsServiceMock) ListAllContext(ctx context.Context, orderId int, opt *basecrm.LineItemListOptions) *basecrm.Iter[*basecrm.LineItem] {
+	m.record("ListAllContext", orderId, opt)
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(ctx, orderId, opt)
+	}
+	return IterOf[*basecrm.LineItem]()
+}
+
+func (m *LineItemsServiceMock) Get(orderId int, id int) (*basecrm.LineItem, *basecrm.Response, error) {
+	m.record("Get", orderId, id)
+	if m.GetFunc != nil {
+		return m.GetFunc(orderId, id)
+	}
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(context.Background(), orderId, id)
+	}
+	return nil, nil, nil
+}
+
+func (m *LineItemsServiceMock) GetContext(ctx context.Context, orderId int, id int) (*basecrm.LineItem, *basecrm.Response, error) {
+	m.record("GetContext", orderId, id)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, orderId, id)
+	}
+	return nil, nil, nil
+}
+
+func (m *LineItemsServiceMock) Create(orderId int, lineItem *basecrm.LineItem) (*basecrm.LineItem, *basecrm.Response, error) {
+	m.record("Create", orderId, lineItem)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(orderId, lineItem)
+	}
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(context.Background(), orderId, lineItem)
+	}
+	return nil, nil, nil
+}
+
+func (m *LineItemsServiceMock) CreateContext(ctx context.Context, orderId int, lineItem *basecrm.LineItem) (*basecrm.LineItem, *basecrm.Response, error) {
+	m.record("CreateContext", orderId, lineItem)
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(ctx, orderId, lineItem)
+	}
+	return nil, nil, nil
+}
+
+func (m *LineItemsServiceMock) Delete(orderId int, id int) (bool, *basecrm.Response, error) {
+	m.record("Delete", orderId, id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(orderId, id)
+	}
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(context.Background(), orderId, id)
+	}
+	return false, nil, nil
+}
+
+func (m *LineItemsServiceMock) DeleteContext(ctx context.Context, orderId int, id int) (bool, *basecrm.Response, error) {
+	m.record("DeleteContext", orderId, id)
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(ctx, orderId, id)
+	}
+	return false, nil, nil
+}
+
+// LossReasonsServiceMock is a mock of basecrm.LossReasonsService.
+// Calls are recorded, and delegated to the function of the method when set.
+type LossReasonsServiceMock struct {
+	Recorder
+
+	ListFunc           func(opt *basecrm.LossReasonListOptions) ([]*basecrm.LossReason, *basecrm.Response, error)
+	ListContextFunc    func(ctx context.Context, opt *basecrm.LossReasonListOptions) ([]*basecrm.LossReason, *basecrm.Response, error)
+	ListAllFunc        func(opt *basecrm.LossReasonListOptions) *basecrm.Iter[*basecrm.LossReason]
+	ListAllContextFunc func(ctx context.Context, opt *basecrm.LossReasonListOptions) *basecrm.Iter[*basecrm.LossReason]
+	GetFunc            func(id int) (*basecrm.LossReason, *basecrm.Response, error)
+	GetContextFunc     func(ctx context.Context, id int) (*basecrm.LossReason, *basecrm.Response, error)
+	CreateFunc         func(lossReason *basecrm.LossReason) (*basecrm.LossReason, *basecrm.Response, error)
+	CreateContextFunc  func(ctx context.Context, lossReason *basecrm.LossReason) (*basecrm.LossReason, *basecrm.Response, error)
+	EditFunc           func(id int, lossReason *basecrm.LossReason) (*basecrm.LossReason, *basecrm.Response, error)
+	EditContextFunc    func(ctx context.Context, id int, lossReason *basecrm.LossReason) (*basecrm.LossReason, *basecrm.Response, error)
+	DeleteFunc         func(id int) (bool, *basecrm.Response, error)
+	DeleteContextFunc  func(ctx context.Context, id int) (bool, *basecrm.Response, error)
+}
+
+var _ basecrm.LossReasonsService = (*LossReasonsServiceMock)(nil)
+
+func (m *LossReasonsServiceMock) List(opt *basecrm.LossReasonListOptions) ([]*basecrm.LossReason, *basecrm.Response, error) {
+	m.record("List", opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(opt)
+	}
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(context.Background(), opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *LossReasonsServiceMock) ListContext(ctx context.Context, opt *basecrm.LossReasonListOptions) ([]*basecrm.LossReason, *basecrm.Response, error) {
+	m.record("ListContext", opt)
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(ctx, opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *LossReasonsServiceMock) ListAll(opt *basecrm.LossReasonListOptions) *basecrm.Iter[*basecrm.LossReason] {
+	m.record("ListAll", opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(opt)
+	}
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(context.Background(), opt)
+	}
+	return IterOf[*basecrm.LossReason]()
+}
+
+func (m *LossReasonsServiceMock) ListAllContext(ctx context.Context, opt *basecrm.LossReasonListOptions) *basecrm.Iter[*basecrm.LossReason] {
+	m.record("ListAllContext", opt)
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(ctx, opt)
+	}
+	return IterOf[*basecrm.LossReason]()
+}
+
+func (m *LossReasonsServiceMock) Get(id int) (*basecrm.LossReason, *basecrm.Response, error) {
+	m.record("Get", id)
+	if m.GetFunc != nil {
+		return m.GetFunc(id)
+	}
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(context.Background(), id)
+	}
+	return nil, nil, nil
+}
+
+func (m *LossReasonsServiceMock) GetContext(ctx context.Context, id int) (*basecrm.LossReason, *basecrm.Response, error) {
+	m.record("GetContext", id)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, id)
+	}
+	return nil, nil, nil
+}
+
+func (m *LossReasonsServiceMock) Create(lossReason *basecrm.LossReason) (*basecrm.LossReason, *basecrm.Response, error) {
+	m.record("Create", lossReason)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(lossReason)
+	}
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(context.Background(), lossReason)
+	}
+	return nil, nil, nil
+}
+
+func (m *LossReasonsServiceMock) CreateContext(ctx context.Context, lossReason *basecrm.LossReason) (*basecrm.LossReason, *basecrm.Response, error) {
+	m.record("CreateContext", lossReason)
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(ctx, lossReason)
+	}
+	return nil, nil, nil
+}
+
+func (m *LossReasonsServiceMock) Edit(id int, lossReason *basecrm.LossReason) (*basecrm.LossReason, *basecrm.Response, error) {
+	m.record("Edit", id, lossReason)
+	if m.EditFunc != nil {
+		return m.EditFunc(id, lossReason)
+	}
+	if m.EditContextFunc != nil {
+		return m.EditContextFunc(context.Background(), id, lossReason)
+	}
+	return nil, nil, nil
+}
+
+func (m *LossReasonsServiceMock) EditContext(ctx context.Context, id int, lossReason *basecrm.LossReason) (*basecrm.LossReason, *basecrm.Response, error) {
+	m.record("EditContext", id, lossReason)
+	if m.EditContextFunc != nil {
+		return m.EditContextFunc(ctx, id, lossReason)
+	}
+	return nil, nil, nil
+}
+
+func (m *LossReasonsServiceMock) Delete(id int) (bool, *basecrm.Response, error) {
+	m.record("Delete", id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(id)
+	}
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(context.Background(), id)
+	}
+	return false, nil, nil
+}
+
+func (m *LossReasonsServiceMock) DeleteContext(ctx context.Context, id int) (bool, *basecrm.Response, error) {
+	m.record("DeleteContext", id)
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(ctx, id)
+	}
+	return false, nil, nil
+}
+
+// NotesServiceMock is a mock of basecrm.NotesService.
+// Calls are recorded, and delegated to the function of the method when set.
+type NotesServiceMock struct {
+	Recorder
+
+	ListFunc           func(opt *basecrm.NoteListOptions) ([]*basecrm.Note, *basecrm.Response, error)
+	ListContextFunc    func(ctx context.Context, opt *basecrm.NoteListOptions) ([]*basecrm.Note, *basecrm.Response, error)
+	ListAllFunc        func(opt *basecrm.NoteListOptions) *basecrm.Iter[*basecrm.Note]
+	ListAllContextFunc func(ctx context.Context, opt *basecrm.NoteListOptions) *basecrm.Iter[*basecrm.Note]
+	GetFunc            func(id int) (*basecrm.Note, *basecrm.Response, error)
+	GetContextFunc     func(ctx context.Context, id int) (*basecrm.Note, *basecrm.Response, error)
+	CreateFunc         func(note *basecrm.Note) (*basecrm.Note, *basecrm.Response, error)
+	CreateContextFunc  func(ctx context.Context, note *basecrm.Note) (*basecrm.Note, *basecrm.Response, error)
+	EditFunc           func(id int, note *basecrm.Note) (*basecrm.Note, *basecrm.Response, error)
+	EditContextFunc    func(ctx context.Context, id int, note *basecrm.Note) (*basecrm.Note, *basecrm.Response, error)
+	DeleteFunc         func(id int) (bool, *basecrm.Response, error)
+	DeleteContextFunc  func(ctx context.Context, id int) (bool, *basecrm.Response, error)
+}
+
+var _ basecrm.NotesService = (*NotesServiceMock)(nil)
+
+func (m *NotesServiceMock) List(opt *basecrm.NoteListOptions) ([]*basecrm.Note, *basecrm.Response, error) {
+	m.record("List", opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(opt)
+	}
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(context.Background(), opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *NotesServiceMock) ListContext(ctx context.Context, opt *basecrm.NoteListOptions) ([]*basecrm.Note, *basecrm.Response, error) {
+	m.record("ListContext", opt)
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(ctx, opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *NotesServiceMock) ListAll(opt *basecrm.NoteListOptions) *basecrm.Iter[*basecrm.Note] {
+	m.record("ListAll", opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(opt)
+	}
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(context.Background(), opt)
+	}
+	return IterOf[*basecrm.Note]()
+}
+
+func (m *NotesServiceMock) ListAllContext(ctx context.Context, opt *basecrm.NoteListOptions) *basecrm.Iter[*basecrm.Note] {
+	m.record("ListAllContext", opt)
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(ctx, opt)
+	}
+	return IterOf[*basecrm.Note]()
+}
+
+func (m *NotesServiceMock) Get(id int) (*basecrm.Note, *basecrm.Response, error) {
+	m.record("Get", id)
+	if m.GetFunc != nil {
+		return m.GetFunc(id)
+	}
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(context.Background(), id)
+	}
+	return nil, nil, nil
+}
+
+func (m *NotesServiceMock) GetContext(ctx context.Context, id int) (*basecrm.Note, *basecrm.Response, error) {
+	m.record("GetContext", id)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, id)
+	}
+	return nil, nil, nil
+}
+
+func (m *NotesServiceMock) Create(note *basecrm.Note) (*basecrm.Note, *basecrm.Response, error) {
+	m.record("Create", note)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(note)
+	}
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(context.Background(), note)
+	}
+	return nil, nil, nil
+}
+
+func (m *NotesServiceMock) CreateContext(ctx context.Context, note *basecrm.Note) (*basecrm.Note, *basecrm.Response, error) {
+	m.record("CreateContext", note)
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(ctx, note)
+	}
+	return nil, nil, nil
+}
+
+func (m *NotesServiceMock) Edit(id int, note *basecrm.Note) (*basecrm.Note, *basecrm.Response, error) {
+	m.record("Edit", id, note)
+	if m.EditFunc != nil {
+		return m.EditFunc(id, note)
+	}
+	if m.EditContextFunc != nil {
+		return m.EditContextFunc(context.Background(), id, note)
+	}
+	return nil, nil, nil
+}
+
+func (m *NotesServiceMock) EditContext(ctx context.Context, id int, note *basecrm.Note) (*basecrm.Note, *basecrm.Response, error) {
+	m.record("EditContext", id, note)
+	if m.EditContextFunc != nil {
+		return m.EditContextFunc(ctx, id, note)
+	}
+	return nil, nil, nil
+}
+
+func (m *NotesServiceMock) Delete(id int) (bool, *basecrm.Response, error) {
+	m.record("Delete", id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(id)
+	}
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(context.Background(), id)
+	}
+	return false, nil, nil
+}
+
+func (m *NotesServiceMock) DeleteContext(ctx context.Context, id int) (bool, *basecrm.Response, error) {
+	m.record("DeleteContext", id)
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(ctx, id)
+	}
+	return false, nil, nil
+}
+
+// OrdersServiceMock is a mock of basecrm.OrdersService.
+// Calls are recorded, and delegated to the function of the method when set.
+type OrdersServiceMock struct {
+	Recorder
+
+	ListFunc           func(opt *basecrm.OrderListOptions) ([]*basecrm.Order, *basecrm.Response, error)
+	ListContextFunc    func(ctx context.Context, opt *basecrm.OrderListOptions) ([]*basecrm.Order, *basecrm.Response, error)
+	ListAllFunc        func(opt *basecrm.OrderListOptions) *basecrm.Iter[*basecrm.Order]
+	ListAllContextFunc func(ctx context.Context, opt *basecrm.OrderListOptions) *basecrm.Iter[*basecrm.Order]
+	GetFunc            func(id int) (*basecrm.Order, *basecrm.Response, error)
+	GetContextFunc     func(ctx context.Context, id int) (*basecrm.Order, *basecrm.Response, error)
+	CreateFunc         func(order *basecrm.Order) (*basecrm.Order, *basecrm.Response, error)
+	CreateContextFunc  func(ctx context.Context, order *basecrm.Order) (*basecrm.Order, *basecrm.Response, error)
+	EditFunc           func(id int, order *basecrm.Order) (*basecrm.Order, *basecrm.Response, error)
+	EditContextFunc    func(ctx context.Context, id int, order *basecrm.Order) (*basecrm.Order, *basecrm.Response, error)
+	DeleteFunc         func(id int) (bool, *basecrm.Response, error)
+	DeleteContextFunc  func(ctx context.Context, id int) (bool, *basecrm.Response, error)
+}
+
+var _ basecrm.OrdersService = (*OrdersServiceMock)(nil)
+
+func (m *OrdersServiceMock) List(opt *basecrm.OrderListOptions) ([]*basecrm.Order, *basecrm.Response, error) {
+	m.record("List", opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(opt)
+	}
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(context.Background(), opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *OrdersServiceMock) ListContext(ctx context.Context, opt *basecrm.OrderListOptions) ([]*basecrm.Order, *basecrm.Response, error) {
+	m.record("ListContext", opt)
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(ctx, opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *OrdersServiceMock) ListAll(opt *basecrm.OrderListOptions) *basecrm.Iter[*basecrm.Order] {
+	m.record("ListAll", opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(opt)
+	}
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(context.Background(), opt)
+	}
+	return IterOf[*basecrm.Order]()
+}
+
+func (m *OrdersServiceMock) ListAllContext(ctx context.Context, opt *basecrm.OrderListOptions) *basecrm.Iter[*basecrm.Order] {
+	m.record("ListAllContext", opt)
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(ctx, opt)
+	}
+	return IterOf[*basecrm.Order]()
+}
+
+func (m *OrdersServiceMock) Get(id int) (*basecrm.Order, *basecrm.Response, error) {
+	m.record("Get", id)
+	if m.GetFunc != nil {
+		return m.GetFunc(id)
+	}
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(context.Background(), id)
+	}
+	return nil, nil, nil
+}
+
+func (m *OrdersServiceMock) GetContext(ctx context.Context, id int) (*basecrm.Order, *basecrm.Response, error) {
+	m.record("GetContext", id)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, id)
+	}
+	return nil, nil, nil
+}
+
+func (m *OrdersServiceMock) Create(order *basecrm.Order) (*basecrm.Order, *basecrm.Response, error) {
+	m.record("Create", order)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(order)
+	}
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(context.Background(), order)
+	}
+	return nil, nil, nil
+}
+
+func (m *OrdersServiceMock) CreateContext(ctx context.Context, order *basecrm.Order) (*basecrm.Order, *basecrm.Response, error) {
+	m.record("CreateContext", order)
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(ctx, order)
+	}
+	return nil, nil, nil
+}
+
+func (m *OrdersServiceMock) Edit(id int, order *basecrm.Order) (*basecrm.Order, *basecrm.Response, error) {
+	m.record("Edit", id, order)
+	if m.EditFunc != nil {
+		return m.EditFunc(id, order)
+	}
+	if m.EditContextFunc != nil {
+		return m.EditContextFunc(context.Background(), id, order)
+	}
+	return nil, nil, nil
+}
+
+func (m *OrdersServiceMock) EditContext(ctx context.Context, id int, order *basecrm.Order) (*basecrm.Order, *basecrm.Response, error) {
+	m.record("EditContext", id, order)
+	if m.EditContextFunc != nil {
+		return m.EditContextFunc(ctx, id, order)
+	}
+	return nil, nil, nil
+}
+
+func (m *OrdersServiceMock) Delete(id int) (bool, *basecrm.Response, error) {
+	m.record("Delete", id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(id)
+	}
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(context.Background(), id)
+	}
+	return false, nil, nil
+}
+
+func (m *OrdersServiceMock) DeleteContext(ctx context.Context, id int) (bool, *basecrm.Response, error) {
+	m.record("DeleteContext", id)
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(ctx, id)
+	}
+	return false, nil, nil
+}
+
+// PipelinesServiceMock is a mock of basecrm.PipelinesService.
+// Calls are recorded, and delegated to the function of the method when set.
+type PipelinesServiceMock struct {
+	Recorder
+
+	ListFunc           func(opt *basecrm.PipelineListOptions) ([]*basecrm.Pipeline, *basecrm.Response, error)
+	ListContextFunc    func(ctx context.Context, opt *basecrm.PipelineListOptions) ([]*basecrm.Pipeline, *basecrm.Response, error)
+	ListAllFunc        func(opt *basecrm.PipelineListOptions) *basecrm.Iter[*basecrm.Pipeline]
+	ListAllContextFunc func(ctx context.Context, opt *basecrm.PipelineListOptions) *basecrm.Iter[*basecrm.Pipeline]
+	GetFunc            func(id int) (*basecrm.Pipeline, *basecrm.Response, error)
+	GetContextFunc     func(ctx context.Context, id int) (*basecrm.Pipeline, *basecrm.Response, error)
+}
+
+var _ basecrm.PipelinesService = (*PipelinesServiceMock)(nil)
+
+func (m *PipelinesServiceMock) List(opt *basecrm.PipelineListOptions) ([]*basecrm.Pipeline, *basecrm.Response, error) {
+	m.record("List", opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(opt)
+	}
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(context.Background(), opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *PipelinesServiceMock) ListContext(ctx context.Context, opt *basecrm.PipelineListOptions) ([]*basecrm.Pipeline, *basecrm.Response, error) {
+	m.record("ListContext", opt)
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(ctx, opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *PipelinesServiceMock) ListAll(opt *basecrm.PipelineListOptions) *basecrm.Iter[*basecrm.Pipeline] {
+	m.record("ListAll", opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(opt)
+	}
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(context.Background(), opt)
+	}
+	return IterOf[*basecrm.Pipeline]()
+}
+
+func (m *PipelinesServiceMock) ListAllContext(ctx context.Context, opt *basecrm.PipelineListOptions) *basecrm.Iter[*basecrm.Pipeline] {
+	m.record("ListAllContext", opt)
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(ctx, opt)
+	}
+	return IterOf[*basecrm.Pipeline]()
+}
+
+func (m *PipelinesServiceMock) Get(id int) (*basecrm.Pipeline, *basecrm.Response, error) {
+	m.record("Get", id)
+	if m.GetFunc != nil {
+		return m.GetFunc(id)
+	}
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(context.Background(), id)
+	}
+	return nil, nil, nil
+}
+
+func (m *PipelinesServiceMock) GetContext(ctx context.Context, id int) (*basecrm.Pipeline, *basecrm.Response, error) {
+	m.record("GetContext", id)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, id)
+	}
+	return nil, nil, nil
+}
+
+// ProductsServiceMock is a mock of basecrm.ProductsService.
+// Calls are recorded, and delegated to the function of the method when set.
+type ProductsServiceMock struct {
+	Recorder
+
+	ListFunc           func(opt *basecrm.ProductListOptions) ([]*basecrm.Product, *basecrm.Response, error)
+	ListContextFunc    func(ctx context.Context, opt *basecrm.ProductListOptions) ([]*basecrm.Product, *basecrm.Response, error)
+	ListAllFunc        func(opt *basecrm.ProductListOptions) *basecrm.Iter[*basecrm.Product]
+	ListAllContextFunc func(ctx context.Context, opt *basecrm.ProductListOptions) *basecrm.Iter[*basecrm.Product]
+	GetFunc            func(id int) (*basecrm.Product, *basecrm.Response, error)
+	GetContextFunc     func(ctx context.Context, id int) (*basecrm.Product, *basecrm.Response, error)
+	CreateFunc         func(product *basecrm.Product) (*basecrm.Product, *basecrm.Response, error)
+	CreateContextFunc  func(ctx context.Context, product *basecrm.Product) (*basecrm.Product, *basecrm.Response, error)
+	EditFunc           func(id int, product *basecrm.Product) (*basecrm.Product, *basecrm.Response, error)
+	EditContextFunc    func(ctx context.Context, id int, product *basecrm.Product) (*basecrm.Product, *basecrm.Response, error)
+	DeleteFunc         func(id int) (bool, *basecrm.Response, error)
+	DeleteContextFunc  func(ctx context.Context, id int) (bool, *basecrm.Response, error)
+}
+
+var _ basecrm.ProductsService = (*ProductsServiceMock)(nil)
+
+func (m *ProductsServiceMock) List(opt *basecrm.ProductListOptions) ([]*basecrm.Product, *basecrm.Response, error) {
+	m.record("List", opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(opt)
+	}
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(context.Background(), opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *ProductsServiceMock) ListContext(ctx context.Context, opt *basecrm.ProductListOptions) ([]*basecrm.Product, *basecrm.Response, error) {
+	m.record("ListContext", opt)
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(ctx, opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *ProductsServiceMock) ListAll(opt *basecrm.ProductListOptions) *basecrm.Iter[*basecrm.Product] {
+	m.record("ListAll", opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(opt)
+	}
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(context.Background(), opt)
+	}
+	return IterOf[*basecrm.Product]()
+}
+
+func (m *ProductsServiceMock) ListAllContext(ctx context.Context, opt *basecrm.ProductListOptions) *basecrm.Iter[*basecrm.Product] {
+	m.record("ListAllContext", opt)
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(ctx, opt)
+	}
+	return IterOf[*basecrm.Product]()
+}
+
+func (m *ProductsServiceMock) Get(id int) (*basecrm.Product, *basecrm.Response, error) {
+	m.record("Get", id)
+	if m.GetFunc != nil {
+		return m.GetFunc(id)
+	}
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(context.Background(), id)
+	}
+	return nil, nil, nil
+}
+
+func (m *ProductsServiceMock) GetContext(ctx context.Context, id int) (*basecrm.Product, *basecrm.Response, error) {
+	m.record("GetContext", id)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, id)
+	}
+	return nil, nil, nil
+}
+
+func (m *ProductsServiceMock) Create(product *basecrm.Product) (*basecrm.Product, *basecrm.Response, error) {
+	m.record("Create", product)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(product)
+	}
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(context.Background(), product)
+	}
+	return nil, nil, nil
+}
+
+func (m *ProductsServiceMock) CreateContext(ctx context.Context, product *basecrm.Product) (*basecrm.Product, *basecrm.Response, error) {
+	m.record("CreateContext", product)
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(ctx, product)
+	}
+	return nil, nil, nil
+}
+
+func (m *ProductsServiceMock) Edit(id int, product *basecrm.Product) (*basecrm.Product, *basecrm.Response, error) {
+	m.record("Edit", id, product)
+	if m.EditFunc != nil {
+		return m.EditFunc(id, product)
+	}
+	if m.EditContextFunc != nil {
+		return m.EditContextFunc(context.Background(), id, product)
+	}
+	return nil, nil, nil
+}
+
+func (m *ProductsServiceMock) EditContext(ctx context.Context, id int, product *basecrm.Product) (*basecrm.Product, *basecrm.Response, error) {
+	m.record("EditContext", id, product)
+	if m.EditContextFunc != nil {
+		return m.EditContextFunc(ctx, id, product)
+	}
+	return nil, nil, nil
+}
+
+func (m *ProductsServiceMock) Delete(id int) (bool, *basecrm.Response, error) {
+	m.record("Delete", id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(id)
+	}
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(context.Background(), id)
+	}
+	return false, nil, nil
+}
+
+func (m *ProductsServiceMock) DeleteContext(ctx context.Context, id int) (bool, *basecrm.Response, error) {
+	m.record("DeleteContext", id)
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(ctx, id)
+	}
+	return false, nil, nil
+}
+
+// SearchServiceMock is a mock of basecrm.SearchService.
+// Calls are recorded, and delegated to the function of the method when set.
+type SearchServiceMock struct {
+	Recorder
+
+	SearchFunc        func(resourceType basecrm.ResourceType, q *basecrm.SearchQuery) (*basecrm.SearchResult, *basecrm.Response, error)
+	SearchContextFunc func(ctx context.Context, resourceType basecrm.ResourceType, q *basecrm.SearchQuery) (*basecrm.SearchResult, *basecrm.Response, error)
+}
+
+var _ basecrm.SearchService = (*SearchServiceMock)(nil)
+
+func (m *SearchServiceMock) Search(resourceType basecrm.ResourceType, q *basecrm.SearchQuery) (*basecrm.SearchResult, *basecrm.Response, error) {
+	m.record("Search", resourceType, q)
+	if m.SearchFunc != nil {
+		return m.SearchFunc(resourceType, q)
+	}
+	if m.SearchContextFunc != nil {
+		return m.SearchContextFunc(context.Background(), resourceType, q)
+	}
+	return nil, nil, nil
+}
+
+func (m *SearchServiceMock) SearchContext(ctx context.Context, resourceType basecrm.ResourceType, q *basecrm.SearchQuery) (*basecrm.SearchResult, *basecrm.Response, error) {
+	m.record("SearchContext", resourceType, q)
+	if m.SearchContextFunc != nil {
+		return m.SearchContextFunc(ctx, resourceType, q)
+	}
+	return nil, nil, nil
+}
+
+// SourcesServiceMock is a mock of basecrm.SourcesService.
+// Calls are recorded, and delegated to the function of the method when set.
+type SourcesServiceMock struct {
+	Recorder
+
+	ListFunc           func(opt *basecrm.SourceListOptions) ([]*basecrm.Source, *basecrm.Response, error)
+	ListContextFunc    func(ctx context.Context, opt *basecrm.SourceListOptions) ([]*basecrm.Source, *basecrm.Response, error)
+	ListAllFunc        func(opt *basecrm.SourceListOptions) *basecrm.Iter[*basecrm.Source]
+	ListAllContextFunc func(ctx context.Context, opt *basecrm.SourceListOptions) *basecrm.Iter[*basecrm.Source]
+	GetFunc            func(id int) (*basecrm.Source, *basecrm.Response, error)
+	GetContextFunc     func(ctx context.Context, id int) (*basecrm.Source, *basecrm.Response, error)
+	CreateFunc         func(source *basecrm.Source) (*basecrm.Source, *basecrm.Response, error)
+	CreateContextFunc  func(ctx context.Context, source *basecrm.Source) (*basecrm.Source, *basecrm.Response, error)
+	EditFunc           func(id int, source *basecrm.Source) (*basecrm.Source, *basecrm.Response, error)
+	EditContextFunc    func(ctx context.Context, id int, source *basecrm.Source) (*basecrm.Source, *basecrm.Response, error)
+	DeleteFunc         func(id int) (bool, *basecrm.Response, error)
+	DeleteContextFunc  func(ctx context.Context, id int) (bool, *basecrm.Response, error)
+}
+
+var _ basecrm.SourcesService = (*SourcesServiceMock)(nil)
+
+func (m *SourcesServiceMock) List(opt *basecrm.SourceListOptions) ([]*basecrm.Source, *basecrm.Response, error) {
+	m.record("List", opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(opt)
+	}
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(context.Background(), opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *SourcesServiceMock) ListContext(ctx context.Context, opt *basecrm.SourceListOptions) ([]*basecrm.Source, *basecrm.Response, error) {
+	m.record("ListContext", opt)
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(ctx, opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *SourcesServiceMock) ListAll(opt *basecrm.SourceListOptions) *basecrm.Iter[*basecrm.Source] {
+	m.record("ListAll", opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(opt)
+	}
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(context.Background(), opt)
+	}
+	return IterOf[*basecrm.Source]()
+}
+
+func (m *SourcesServiceMock) ListAllContext(ctx context.Context, opt *basecrm.SourceListOptions) *basecrm.Iter[*basecrm.Source] {
+	m.record("ListAllContext", opt)
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(ctx, opt)
+	}
+	return IterOf[*basecrm.Source]()
+}
+
+func (m *SourcesServiceMock) Get(id int) (*basecrm.Source, *basecrm.Response, error) {
+	m.record("Get", id)
+	if m.GetFunc != nil {
+		return m.GetFunc(id)
+	}
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(context.Background(), id)
+	}
+	return nil, nil, nil
+}
+
+func (m *SourcesServiceMock) GetContext(ctx context.Context, id int) (*basecrm.Source, *basecrm.Response, error) {
+	m.record("GetContext", id)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, id)
+	}
+	return nil, nil, nil
+}
+
+func (m *SourcesServiceMock) Create(source *basecrm.Source) (*basecrm.Source, *basecrm.Response, error) {
+	m.record("Create", source)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(source)
+	}
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(context.Background(), source)
+	}
+	return nil, nil, nil
+}
+
+func (m *SourcesServiceMock) CreateContext(ctx context.Context, source *basecrm.Source) (*basecrm.Source, *basecrm.Response, error) {
+	m.record("CreateContext", source)
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(ctx, source)
+	}
+	return nil, nil, nil
+}
+
+func (m *SourcesServiceMock) Edit(id int, source *basecrm.Source) (*basecrm.Source, *basecrm.Response, error) {
+	m.record("Edit", id, source)
+	if m.EditFunc != nil {
+		return m.EditFunc(id, source)
+	}
+	if m.EditContextFunc != nil {
+		return m.EditContextFunc(context.Background(), id, source)
+	}
+	return nil, nil, nil
+}
+
+func (m *SourcesServiceMock) EditContext(ctx context.Context, id int, source *basecrm.Source) (*basecrm.Source, *basecrm.Response, error) {
+	m.record("EditContext", id, source)
+	if m.EditContextFunc != nil {
+		return m.EditContextFunc(ctx, id, source)
+	}
+	return nil, nil, nil
+}
+
+func (m *SourcesServiceMock) Delete(id int) (bool, *basecrm.Response, error) {
+	m.record("Delete", id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(id)
+	}
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(context.Background(), id)
+	}
+	return false, nil, nil
+}
+
+func (m *SourcesServiceMock) DeleteContext(ctx context.Context, id int) (bool, *basecrm.Response, error) {
+	m.record("DeleteContext", id)
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(ctx, id)
+	}
+	return false, nil, nil
+}
+
+// StagesServiceMock is a mock of basecrm.StagesService.
+// Calls are recorded, and delegated to the function of the method when set.
+type StagesServiceMock struct {
+	Recorder
+
+	ListFunc           func(opt *basecrm.StageListOptions) ([]*basecrm.Stage, *basecrm.Response, error)
+	ListContextFunc    func(ctx context.Context, opt *basecrm.StageListOptions) ([]*basecrm.Stage, *basecrm.Response, error)
+	ListAllFunc        func(opt *basecrm.StageListOptions) *basecrm.Iter[*basecrm.Stage]
+	ListAllContextFunc func(ctx context.Context, opt *basecrm.StageListOptions) *basecrm.Iter[*basecrm.Stage]
+	GetFunc            func(id int) (*basecrm.Stage, *basecrm.Response, error)
+	GetContextFunc     func(ctx context.Context, id int) (*basecrm.Stage, *basecrm.Response, error)
+}
+
+var _ basecrm.StagesService = (*StagesServiceMock)(nil)
+
+func (m *StagesServiceMock) List(opt *basecrm.StageListOptions) ([]*basecrm.Stage, *basecrm.Response, error) {
+	m.record("List", opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(opt)
+	}
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(context.Background(), opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *StagesServiceMock) ListContext(ctx context.Context, opt *basecrm.StageListOptions) ([]*basecrm.Stage, *basecrm.Response, error) {
+	m.record("ListContext", opt)
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(ctx, opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *StagesServiceMock) ListAll(opt *basecrm.StageListOptions) *basecrm.Iter[*basecrm.Stage] {
+	m.record("ListAll", opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(opt)
+	}
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(context.Background(), opt)
+	}
+	return IterOf[*basecrm.Stage]()
+}
+
+func (m *StagesServiceMock) ListAllContext(ctx context.Context, opt *basecrm.StageListOptions) *basecrm.Iter[*basecrm.Stage] {
+	m.record("ListAllContext", opt)
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(ctx, opt)
+	}
+	return IterOf[*basecrm.Stage]()
+}
+
+func (m *StagesServiceMock) Get(id int) (*basecrm.Stage, *basecrm.Response, error) {
+	m.record("Get", id)
+	if m.GetFunc != nil {
+		return m.GetFunc(id)
+	}
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(context.Background(), id)
+	}
+	return nil, nil, nil
+}
+
+func (m *StagesServiceMock) GetContext(ctx context.Context, id int) (*basecrm.Stage, *basecrm.Response, error) {
+	m.record("GetContext", id)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, id)
+	}
+	return nil, nil, nil
+}
+
+// SyncServiceMock is a mock of basecrm.SyncService.
+// Calls are recorded, and delegated to the function of the method when set.
+type SyncServiceMock struct {
+	Recorder
+
+	StartFunc        func(deviceUUID string) (*basecrm.SyncSession, *basecrm.Response, error)
+	StartContextFunc func(ctx context.Context, deviceUUID string) (*basecrm.SyncSession, *basecrm.Response, error)
+	FetchFunc        func(deviceUUID string, sessionId string, queue string) ([]*basecrm.SyncItem, *basecrm.Response, error)
+	FetchContextFunc func(ctx context.Context, deviceUUID string, sessionId string, queue string) ([]*basecrm.SyncItem, *basecrm.Response, error)
+	AckFunc          func(deviceUUID string, ackKeys []string) (bool, *basecrm.Response, error)
+	AckContextFunc   func(ctx context.Context, deviceUUID string, ackKeys []string) (bool, *basecrm.Response, error)
+}
+
+var _ basecrm.SyncService = (*SyncServiceMock)(nil)
+
+func (m *SyncServiceMock) Start(deviceUUID string) (*basecrm.SyncSession, *basecrm.Response, error) {
+	m.record("Start", deviceUUID)
+	if m.StartFunc != nil {
+		return m.StartFunc(deviceUUID)
+	}
+	if m.StartContextFunc != nil {
+		return m.StartContextFunc(context.Background(), deviceUUID)
+	}
+	return nil, nil, nil
+}
+
+func (m *SyncServiceMock) StartContext(ctx context.Context, deviceUUID string) (*basecrm.SyncSession, *basecrm.Response, error) {
+	m.record("StartContext", deviceUUID)
+	if m.StartContextFunc != nil {
+		return m.StartContextFunc(ctx, deviceUUID)
+	}
+	return nil, nil, nil
+}
+
+func (m *SyncServiceMock) Fetch(deviceUUID string, sessionId string, queue string) ([]*basecrm.SyncItem, *basecrm.Response, error) {
+	m.record("Fetch", deviceUUID, sessionId, queue)
+	if m.FetchFunc != nil {
+		return m.FetchFunc(deviceUUID, sessionId, queue)
+	}
+	if m.FetchContextFunc != nil {
+		return m.FetchContextFunc(context.Background(), deviceUUID, sessionId, queue)
+	}
+	return nil, nil, nil
+}
+
+func (m *SyncServiceMock) FetchContext(ctx context.Context, deviceUUID string, sessionId string, queue string) ([]*basecrm.SyncItem, *basecrm.Response, error) {
+	m.record("FetchContext", deviceUUID, sessionId, queue)
+	if m.FetchContextFunc != nil {
+		return m.FetchContextFunc(ctx, deviceUUID, sessionId, queue)
+	}
+	return nil, nil, nil
+}
+
+func (m *SyncServiceMock) Ack(deviceUUID string, ackKeys []string) (bool, *basecrm.Response, error) {
+	m.record("Ack", deviceUUID, ackKeys)
+	if m.AckFunc != nil {
+		return m.AckFunc(deviceUUID, ackKeys)
+	}
+	if m.AckContextFunc != nil {
+		return m.AckContextFunc(context.Background(), deviceUUID, ackKeys)
+	}
+	return false, nil, nil
+}
+
+func (m *SyncServiceMock) AckContext(ctx context.Context, deviceUUID string, ackKeys []string) (bool, *basecrm.Response, error) {
+	m.record("AckContext", deviceUUID, ackKeys)
+	if m.AckContextFunc != nil {
+		return m.AckContextFunc(ctx, deviceUUID, ackKeys)
+	}
+	return false, nil, nil
+}
+
+// TagsServiceMock is a mock of basecrm.TagsService.
+// Calls are recorded, and delegated to the function of the method when set.
+type TagsServiceMock struct {
+	Recorder
+
+	ListFunc           func(opt *basecrm.TagListOptions) ([]*basecrm.Tag, *basecrm.Response, error)
+	ListContextFunc    func(ctx context.Context, opt *basecrm.TagListOptions) ([]*basecrm.Tag, *basecrm.Response, error)
+	ListAllFunc        func(opt *basecrm.TagListOptions) *basecrm.Iter[*basecrm.Tag]
+	ListAllContextFunc func(ctx context.Context, opt *basecrm.TagListOptions) *basecrm.Iter[*basecrm.Tag]
+	GetFunc            func(id int) (*basecrm.Tag, *basecrm.Response, error)
+	GetContextFunc     func(ctx context.Context, id int) (*basecrm.Tag, *basecrm.Response, error)
+	CreateFunc         func(tag *basecrm.Tag) (*basecrm.Tag, *basecrm.Response, error)
+	CreateContextFunc  func(ctx context.Context, tag *basecrm.Tag) (*basecrm.Tag, *basecrm.Response, error)
+	EditFunc           func(id int, tag *basecrm.Tag) (*basecrm.Tag, *basecrm.Response, error)
+	EditContextFunc    func(ctx context.Context, id int, tag *basecrm.Tag) (*basecrm.Tag, *basecrm.Response, error)
+	DeleteFunc         func(id int) (bool, *basecrm.Response, error)
+	DeleteContextFunc  func(ctx context.Context, id int) (bool, *basecrm.Response, error)
+}
+
+var _ basecrm.TagsService = (*TagsServiceMock)(nil)
+
+func (m *TagsServiceMock) List(opt *basecrm.TagListOptions) ([]*basecrm.Tag, *basecrm.Response, error) {
+	m.record("List", opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(opt)
+	}
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(context.Background(), opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *TagsServiceMock) ListContext(ctx context.Context, opt *basecrm.TagListOptions) ([]*basecrm.Tag, *basecrm.Response, error) {
+	m.record("ListContext", opt)
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(ctx, opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *TagsServiceMock) ListAll(opt *basecrm.TagListOptions) *basecrm.Iter[*basecrm.Tag] {
+	m.record("ListAll", opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(opt)
+	}
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(context.Background(), opt)
+	}
+	return IterOf[*basecrm.Tag]()
+}
+
+func (m *TagsServiceMock) ListAllContext(ctx context.Context, opt *basecrm.TagListOptions) *basecrm.Iter[*basecrm.Tag] {
+	m.record("ListAllContext", opt)
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(ctx, opt)
+	}
+	return IterOf[*basecrm.Tag]()
+}
+
+func (m *TagsServiceMock) Get(id int) (*basecrm.Tag, *basecrm.Response, error) {
+	m.record("Get", id)
+	if m.GetFunc != nil {
+		return m.GetFunc(id)
+	}
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(context.Background(), id)
+	}
+	return nil, nil, nil
+}
+
+func (m *TagsServiceMock) GetContext(ctx context.Context, id int) (*basecrm.Tag, *basecrm.Response, error) {
+	m.record("GetContext", id)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, id)
+	}
+	return nil, nil, nil
+}
+
+func (m *TagsServiceMock) Create(tag *basecrm.Tag) (*basecrm.Tag, *basecrm.Response, error) {
+	m.record("Create", tag)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(tag)
+	}
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(context.Background(), tag)
+	}
+	return nil, nil, nil
+}
+
+func (m *TagsServiceMock) CreateContext(ctx context.Context, tag *basecrm.Tag) (*basecrm.Tag, *basecrm.Response, error) {
+	m.record("CreateContext", tag)
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(ctx, tag)
+	}
+	return nil, nil, nil
+}
+
+func (m *TagsServiceMock) Edit(id int, tag *basecrm.Tag) (*basecrm.Tag, *basecrm.Response, error) {
+	m.record("Edit", id, tag)
+	if m.EditFunc != nil {
+		return m.EditFunc(id, tag)
+	}
+	if m.EditContextFunc != nil {
+		return m.EditContextFunc(context.Background(), id, tag)
+	}
+	return nil, nil, nil
+}
+
+func (m *TagsServiceMock) EditContext(ctx context.Context, id int, tag *basecrm.Tag) (*basecrm.Tag, *basecrm.Response, error) {
+	m.record("EditContext", id, tag)
+	if m.EditContextFunc != nil {
+		return m.EditContextFunc(ctx, id, tag)
+	}
+	return nil, nil, nil
+}
+
+func (m *TagsServiceMock) Delete(id int) (bool, *basecrm.Response, error) {
+	m.record("Delete", id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(id)
+	}
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(context.Background(), id)
+	}
+	return false, nil, nil
+}
+
+func (m *TagsServiceMock) DeleteContext(ctx context.Context, id int) (bool, *basecrm.Response, error) {
+	m.record("DeleteContext", id)
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(ctx, id)
+	}
+	return false, nil, nil
+}
+
+// TasksServiceMock is a mock of basecrm.TasksService.
+// Calls are recorded, and delegated to the function of the method when set.
+type TasksServiceMock struct {
+	Recorder
+
+	ListFunc           func(opt *basecrm.TaskListOptions) ([]*basecrm.Task, *basecrm.Response, error)
+	ListContextFunc    func(ctx context.Context, opt *basecrm.TaskListOptions) ([]*basecrm.Task, *basecrm.Response, error)
+	ListAllFunc        func(opt *basecrm.TaskListOptions) *basecrm.Iter[*basecrm.Task]
+	ListAllContextFunc func(ctx context.Context, opt *basecrm.TaskListOptions) *basecrm.Iter[*basecrm.Task]
+	GetFunc            func(id int) (*basecrm.Task, *basecrm.Response, error)
+	GetContextFunc     func(ctx context.Context, id int) (*basecrm.Task, *basecrm.Response, error)
+	CreateFunc         func(task *basecrm.Task) (*basecrm.Task, *basecrm.Response, error)
+	CreateContextFunc  func(ctx context.Context, task *basecrm.Task) (*basecrm.Task, *basecrm.Response, error)
+	EditFunc           func(id int, task *basecrm.Task) (*basecrm.Task, *basecrm.Response, error)
+	EditContextFunc    func(ctx context.Context, id int, task *basecrm.Task) (*basecrm.Task, *basecrm.Response, error)
+	DeleteFunc         func(id int) (bool, *basecrm.Response, error)
+	DeleteContextFunc  func(ctx context.Context, id int) (bool, *basecrm.Response, error)
+}
+
+var _ basecrm.TasksService = (*TasksServiceMock)(nil)
+
+func (m *TasksServiceMock) List(opt *basecrm.TaskListOptions) ([]*basecrm.Task, *basecrm.Response, error) {
+	m.record("List", opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(opt)
+	}
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(context.Background(), opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *TasksServiceMock) ListContext(ctx context.Context, opt *basecrm.TaskListOptions) ([]*basecrm.Task, *basecrm.Response, error) {
+	m.record("ListContext", opt)
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(ctx, opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *TasksServiceMock) ListAll(opt *basecrm.TaskListOptions) *basecrm.Iter[*basecrm.Task] {
+	m.record("ListAll", opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(opt)
+	}
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(context.Background(), opt)
+	}
+	return IterOf[*basecrm.Task]()
+}
+
+func (m *TasksServiceMock) ListAllContext(ctx context.Context, opt *basecrm.TaskListOptions) *basecrm.Iter[*basecrm.Task] {
+	m.record("ListAllContext", opt)
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(ctx, opt)
+	}
+	return IterOf[*basecrm.Task]()
+}
+
+func (m *TasksServiceMock) Get(id int) (*basecrm.Task, *basecrm.Response, error) {
+	m.record("Get", id)
+	if m.GetFunc != nil {
+		return m.GetFunc(id)
+	}
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(context.Background(), id)
+	}
+	return nil, nil, nil
+}
+
+func (m *TasksServiceMock) GetContext(ctx context.Context, id int) (*basecrm.Task, *basecrm.Response, error) {
+	m.record("GetContext", id)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, id)
+	}
+	return nil, nil, nil
+}
+
+func (m *TasksServiceMock) Create(task *basecrm.Task) (*basecrm.Task, *basecrm.Response, error) {
+	m.record("Create", task)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(task)
+	}
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(context.Background(), task)
+	}
+	return nil, nil, nil
+}
+
+func (m *TasksServiceMock) CreateContext(ctx context.Context, task *basecrm.Task) (*basecrm.Task, *basecrm.Response, error) {
+	m.record("CreateContext", task)
+	if m.CreateContextFunc != nil {
+		return m.CreateContextFunc(ctx, task)
+	}
+	return nil, nil, nil
+}
+
+func (m *TasksServiceMock) Edit(id int, task *basecrm.Task) (*basecrm.Task, *basecrm.Response, error) {
+	m.record("Edit", id, task)
+	if m.EditFunc != nil {
+		return m.EditFunc(id, task)
+	}
+	if m.EditContextFunc != nil {
+		return m.EditContextFunc(context.Background(), id, task)
+	}
+	return nil, nil, nil
+}
+
+func (m *TasksServiceMock) EditContext(ctx context.Context, id int, task *basecrm.Task) (*basecrm.Task, *basecrm.Response, error) {
+	m.record("EditContext", id, task)
+	if m.EditContextFunc != nil {
+		return m.EditContextFunc(ctx, id, task)
+	}
+	return nil, nil, nil
+}
+
+func (m *TasksServiceMock) Delete(id int) (bool, *basecrm.Response, error) {
+	m.record("Delete", id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(id)
+	}
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(context.Background(), id)
+	}
+	return false, nil, nil
+}
+
+func (m *TasksServiceMock) DeleteContext(ctx context.Context, id int) (bool, *basecrm.Response, error) {
+	m.record("DeleteContext", id)
+	if m.DeleteContextFunc != nil {
+		return m.DeleteContextFunc(ctx, id)
+	}
+	return false, nil, nil
+}
+
+// TextMessagesServiceMock is a mock of basecrm.TextMessagesService.
+// Calls are recorded, and delegated to the function of the method when set.
+type TextMessagesServiceMock struct {
+	Recorder
+
+	ListFunc           func(opt *basecrm.TextMessageListOptions) ([]*basecrm.TextMessage, *basecrm.Response, error)
+	ListContextFunc    func(ctx context.Context, opt *basecrm.TextMessageListOptions) ([]*basecrm.TextMessage, *basecrm.Response, error)
+	ListAllFunc        func(opt *basecrm.TextMessageListOptions) *basecrm.Iter[*basecrm.TextMessage]
+	ListAllContextFunc func(ctx context.Context, opt *basecrm.TextMessageListOptions) *basecrm.Iter[*basecrm.TextMessage]
+	GetFunc            func(id int) (*basecrm.TextMessage, *basecrm.Response, error)
+	GetContextFunc     func(ctx context.Context, id int) (*basecrm.TextMessage, *basecrm.Response, error)
+}
+
+var _ basecrm.TextMessagesService = (*TextMessagesServiceMock)(nil)
+
+func (m *TextMessagesServiceMock) List(opt *basecrm.TextMessageListOptions) ([]*basecrm.TextMessage, *basecrm.Response, error) {
+	m.record("List", opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(opt)
+	}
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(context.Background(), opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *TextMessagesServiceMock) ListContext(ctx context.Context, opt *basecrm.TextMessageListOptions) ([]*basecrm.TextMessage, *basecrm.Response, error) {
+	m.record("ListContext", opt)
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(ctx, opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *TextMessagesServiceMock) ListAll(opt *basecrm.TextMessageListOptions) *basecrm.Iter[*basecrm.TextMessage] {
+	m.record("ListAll", opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(opt)
+	}
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(context.Background(), opt)
+	}
+	return IterOf[*basecrm.TextMessage]()
+}
+
+func (m *TextMessagesServiceMock) ListAllContext(ctx context.Context, opt *basecrm.TextMessageListOptions) *basecrm.Iter[*basecrm.TextMessage] {
+	m.record("ListAllContext", opt)
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(ctx, opt)
+	}
+	return IterOf[*basecrm.TextMessage]()
+}
+
+func (m *TextMessagesServiceMock) Get(id int) (*basecrm.TextMessage, *basecrm.Response, error) {
+	m.record("Get", id)
+	if m.GetFunc != nil {
+		return m.GetFunc(id)
+	}
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(context.Background(), id)
+	}
+	return nil, nil, nil
+}
+
+func (m *TextMessagesServiceMock) GetContext(ctx context.Context, id int) (*basecrm.TextMessage, *basecrm.Response, error) {
+	m.record("GetContext", id)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, id)
+	}
+	return nil, nil, nil
+}
+
+// UsersServiceMock is a mock of basecrm.UsersService.
+// Calls are recorded, and delegated to the function of the method when set.
+type UsersServiceMock struct {
+	Recorder
+
+	ListFunc           func(opt *basecrm.UserListOptions) ([]*basecrm.User, *basecrm.Response, error)
+	ListContextFunc    func(ctx context.Context, opt *basecrm.UserListOptions) ([]*basecrm.User, *basecrm.Response, error)
+	ListAllFunc        func(opt *basecrm.UserListOptions) *basecrm.Iter[*basecrm.User]
+	ListAllContextFunc func(ctx context.Context, opt *basecrm.UserListOptions) *basecrm.Iter[*basecrm.User]
+	GetFunc            func(id int) (*basecrm.User, *basecrm.Response, error)
+	GetContextFunc     func(ctx context.Context, id int) (*basecrm.User, *basecrm.Response, error)
+	SelfFunc           func() (*basecrm.User, *basecrm.Response, error)
+	SelfContextFunc    func(ctx context.Context) (*basecrm.User, *basecrm.Response, error)
+}
+
+var _ basecrm.UsersService = (*UsersServiceMock)(nil)
+
+func (m *UsersServiceMock) List(opt *basecrm.UserListOptions) ([]*basecrm.User, *basecrm.Response, error) {
+	m.record("List", opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(opt)
+	}
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(context.Background(), opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *UsersServiceMock) ListContext(ctx context.Context, opt *basecrm.UserListOptions) ([]*basecrm.User, *basecrm.Response, error) {
+	m.record("ListContext", opt)
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(ctx, opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *UsersServiceMock) ListAll(opt *basecrm.UserListOptions) *basecrm.Iter[*basecrm.User] {
+	m.record("ListAll", opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(opt)
+	}
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(context.Background(), opt)
+	}
+	return IterOf[*basecrm.User]()
+}
+
+func (m *UsersServiceMock) ListAllContext(ctx context.Context, opt *basecrm.UserListOptions) *basecrm.Iter[*basecrm.User] {
+	m.record("ListAllContext", opt)
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(ctx, opt)
+	}
+	return IterOf[*basecrm.User]()
+}
+
+func (m *UsersServiceMock) Get(id int) (*basecrm.User, *basecrm.Response, error) {
+	m.record("Get", id)
+	if m.GetFunc != nil {
+		return m.GetFunc(id)
+	}
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(context.Background(), id)
+	}
+	return nil, nil, nil
+}
+
+func (m *UsersServiceMock) GetContext(ctx context.Context, id int) (*basecrm.User, *basecrm.Response, error) {
+	m.record("GetContext", id)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, id)
+	}
+	return nil, nil, nil
+}
+
+func (m *UsersServiceMock) Self() (*basecrm.User, *basecrm.Response, error) {
+	m.record("Self")
+	if m.SelfFunc != nil {
+		return m.SelfFunc()
+	}
+	if m.SelfContextFunc != nil {
+		return m.SelfContextFunc(context.Background())
+	}
+	return nil, nil, nil
+}
+
+func (m *UsersServiceMock) SelfContext(ctx context.Context) (*basecrm.User, *basecrm.Response, error) {
+	m.record("SelfContext")
+	if m.SelfContextFunc != nil {
+		return m.SelfContextFunc(ctx)
+	}
+	return nil, nil, nil
+}
+
+// VisitOutcomesServiceMock is a mock of basecrm.VisitOutcomesService.
+// Calls are recorded, and delegated to the function of the method when set.
+type VisitOutcomesServiceMock struct {
+	Recorder
+
+	ListFunc           func(opt *basecrm.VisitOutcomeListOptions) ([]*basecrm.VisitOutcome, *basecrm.Response, error)
+	ListContextFunc    func(ctx context.Context, opt *basecrm.VisitOutcomeListOptions) ([]*basecrm.VisitOutcome, *basecrm.Response, error)
+	ListAllFunc        func(opt *basecrm.VisitOutcomeListOptions) *basecrm.Iter[*basecrm.VisitOutcome]
+	ListAllContextFunc func(ctx context.Context, opt *basecrm.VisitOutcomeListOptions) *basecrm.Iter[*basecrm.VisitOutcome]
+	GetFunc            func(id int) (*basecrm.VisitOutcome, *basecrm.Response, error)
+	GetContextFunc     func(ctx context.Context, id int) (*basecrm.VisitOutcome, *basecrm.Response, error)
+}
+
+var _ basecrm.VisitOutcomesService = (*VisitOutcomesServiceMock)(nil)
+
+func (m *VisitOutcomesServiceMock) List(opt *basecrm.VisitOutcomeListOptions) ([]*basecrm.VisitOutcome, *basecrm.Response, error) {
+	m.record("List", opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(opt)
+	}
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(context.Background(), opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *VisitOutcomesServiceMock) ListContext(ctx context.Context, opt *basecrm.VisitOutcomeListOptions) ([]*basecrm.VisitOutcome, *basecrm.Response, error) {
+	m.record("ListContext", opt)
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(ctx, opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *VisitOutcomesServiceMock) ListAll(opt *basecrm.VisitOutcomeListOptions) *basecrm.Iter[*basecrm.VisitOutcome] {
+	m.record("ListAll", opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(opt)
+	}
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(context.Background(), opt)
+	}
+	return IterOf[*basecrm.VisitOutcome]()
+}
+
+func (m *VisitOutcomesServiceMock) ListAllContext(ctx context.Context, opt *basecrm.VisitOutcomeListOptions) *basecrm.Iter[*basecrm.VisitOutcome] {
+	m.record("ListAllContext", opt)
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(ctx, opt)
+	}
+	return IterOf[*basecrm.VisitOutcome]()
+}
+
+func (m *VisitOutcomesServiceMock) Get(id int) (*basecrm.VisitOutcome, *basecrm.Response, error) {
+	m.record("Get", id)
+	if m.GetFunc != nil {
+		return m.GetFunc(id)
+	}
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(context.Background(), id)
+	}
+	return nil, nil, nil
+}
+
+func (m *VisitOutcomesServiceMock) GetContext(ctx context.Context, id int) (*basecrm.VisitOutcome, *basecrm.Response, error) {
+	m.record("GetContext", id)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, id)
+	}
+	return nil, nil, nil
+}
+
+// VisitsServiceMock is a mock of basecrm.VisitsService.
+// Calls are recorded, and delegated to the function of the method when set.
+type VisitsServiceMock struct {
+	Recorder
+
+	ListFunc           func(opt *basecrm.VisitListOptions) ([]*basecrm.Visit, *basecrm.Response, error)
+	ListContextFunc    func(ctx context.Context, opt *basecrm.VisitListOptions) ([]*basecrm.Visit, *basecrm.Response, error)
+	ListAllFunc        func(opt *basecrm.VisitListOptions) *basecrm.Iter[*basecrm.Visit]
+	ListAllContextFunc func(ctx context.Context, opt *basecrm.VisitListOptions) *basecrm.Iter[*basecrm.Visit]
+	GetFunc            func(id int) (*basecrm.Visit, *basecrm.Response, error)
+	GetContextFunc     func(ctx context.Context, id int) (*basecrm.Visit, *basecrm.Response, error)
+}
+
+var _ basecrm.VisitsService = (*VisitsServiceMock)(nil)
+
+func (m *VisitsServiceMock) List(opt *basecrm.VisitListOptions) ([]*basecrm.Visit, *basecrm.Response, error) {
+	m.record("List", opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(opt)
+	}
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(context.Background(), opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *VisitsServiceMock) ListContext(ctx context.Context, opt *basecrm.VisitListOptions) ([]*basecrm.Visit, *basecrm.Response, error) {
+	m.record("ListContext", opt)
+	if m.ListContextFunc != nil {
+		return m.ListContextFunc(ctx, opt)
+	}
+	return nil, nil, nil
+}
+
+func (m *VisitsServiceMock) ListAll(opt *basecrm.VisitListOptions) *basecrm.Iter[*basecrm.Visit] {
+	m.record("ListAll", opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(opt)
+	}
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(context.Background(), opt)
+	}
+	return IterOf[*basecrm.Visit]()
+}
+
+func (m *VisitsServiceMock) ListAllContext(ctx context.Context, opt *basecrm.VisitListOptions) *basecrm.Iter[*basecrm.Visit] {
+	m.record("ListAllContext", opt)
+	if m.ListAllContextFunc != nil {
+		return m.ListAllContextFunc(ctx, opt)
+	}
+	return IterOf[*basecrm.Visit]()
+}
+
+func (m *VisitsServiceMock) Get(id int) (*basecrm.Visit, *basecrm.Response, error) {
+	m.record("Get", id)
+	if m.GetFunc != nil {
+		return m.GetFunc(id)
+	}
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(context.Background(), id)
+	}
+	return nil, nil, nil
+}
+
+func (m *VisitsServiceMock) GetContext(ctx context.Context, id int) (*basecrm.Visit, *basecrm.Response, error) {
+	m.record("GetContext", id)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, id)
+	}
+	return nil, nil, nil
+}
+
+// Mocks holds the mocks of the services of a client returned by NewMockClient.
+type Mocks struct {
+	Accounts               *AccountsServiceMock
+	Users                  *UsersServiceMock
+	Contacts               *ContactsServiceMock
+	Sources                *SourcesServiceMock
+	LossReasons            *LossReasonsServiceMock
+	Leads                  *LeadsServiceMock
+	Deals                  *DealsServiceMock
+	Notes                  *NotesServiceMock
+	Tasks                  *TasksServiceMock
+	Tags                   *TagsServiceMock
+	Pipelines              *PipelinesServiceMock
+	Stages                 *StagesServiceMock
+	Products               *ProductsServiceMock
+	Orders                 *OrdersServiceMock
+	LineItems              *LineItemsServiceMock
+	Calls                  *CallsServiceMock
+	CallOutcomes           *CallOutcomesServiceMock
+	TextMessages           *TextMessagesServiceMock
+	Visits                 *VisitsServiceMock
+	VisitOutcomes          *VisitOutcomesServiceMock
+	LeadUnqualifiedReasons *LeadUnqualifiedReasonsServiceMock
+	DealUnqualifiedReasons *DealUnqualifiedReasonsServiceMock
+	LeadSources            *LeadSourcesServiceMock
+	DealSources            *DealSourcesServiceMock
+	LeadConversions        *LeadConversionsServiceMock
+	CustomFields           *CustomFieldsServiceMock
+	Collaborations         *CollaborationsServiceMock
+	Sync                   *SyncServiceMock
+	Firehose               *FirehoseServiceMock
+	Search                 *SearchServiceMock
+}
+
+// NewMockClient returns a client whose services are mocks.
+func NewMockClient() (*basecrm.Client, *Mocks) {
+	m := &Mocks{
+		Accounts:               &AccountsServiceMock{},
+		Users:                  &UsersServiceMock{},
+		Contacts:               &ContactsServiceMock{},
+		Sources:                &SourcesServiceMock{},
+		LossReasons:            &LossReasonsServiceMock{},
+		Leads:                  &LeadsServiceMock{},
+		Deals:                  &DealsServiceMock{},
+		Notes:                  &NotesServiceMock{},
+		Tasks:                  &TasksServiceMock{},
+		Tags:                   &TagsServiceMock{},
+		Pipelines:              &PipelinesServiceMock{},
+		Stages:                 &StagesServiceMock{},
+		Products:               &ProductsServiceMock{},
+		Orders:                 &OrdersServiceMock{},
+		LineItems:              &LineItemsServiceMock{},
+		Calls:                  &CallsServiceMock{},
+		CallOutcomes:           &CallOutcomesServiceMock{},
+		TextMessages:           &TextMessagesServiceMock{},
+		Visits:                 &VisitsServiceMock{},
+		VisitOutcomes:          &VisitOutcomesServiceMock{},
+		LeadUnqualifiedReasons: &LeadUnqualifiedReasonsServiceMock{},
+		DealUnqualifiedReasons: &DealUnqualifiedReasonsServiceMock{},
+		LeadSources:            &LeadSourcesServiceMock{},
+		DealSources:            &DealSourcesServiceMock{},
+		LeadConversions:        &LeadConversionsServiceMock{},
+		CustomFields:           &CustomFieldsServiceMock{},
+		Collaborations:         &CollaborationsServiceMock{},
+		Sync:                   &SyncServiceMock{},
+		Firehose:               &FirehoseServiceMock{},
+		Search:                 &SearchServiceMock{},
+	}
+
+	c := basecrm.NewClient(nil)
+	c.Accounts = m.Accounts
+	c.Users = m.Users
+	c.Contacts = m.Contacts
+	c.Sources = m.Sources
+	c.LossReasons = m.LossReasons
+	c.Leads = m.Leads
+	c.Deals = m.Deals
+	c.Notes = m.Notes
+	c.Tasks = m.Tasks
+	c.Tags = m.Tags
+	c.Pipelines = m.Pipelines
+	c.Stages = m.Stages
+	c.Products = m.Products
+	c.Orders = m.Orders
+	c.LineItems = m.LineItems
+	c.Calls = m.Calls
+	c.CallOutcomes = m.CallOutcomes
+	c.TextMessages = m.TextMessages
+	c.Visits = m.Visits
+	c.VisitOutcomes = m.VisitOutcomes
+	c.LeadUnqualifiedReasons = m.LeadUnqualifiedReasons
+	c.DealUnqualifiedReasons = m.DealUnqualifiedReasons
+	c.LeadSources = m.LeadSources
+	c.DealSources = m.DealSources
+	c.LeadConversions = m.LeadConversions
+	c.CustomFields = m.CustomFields
+	c.Collaborations = m.Collaborations
+	c.Sync = m.Sync
+	c.Firehose = m.Firehose
+	c.Search = m.Search
+	return c, m
+}
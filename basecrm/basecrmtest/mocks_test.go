@@ -0,0 +1,98 @@
+package basecrmtest
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/iaintshine/basecrm-go/basecrm"
+	"github.com/iaintshine/basecrm-go/basecrm/basecrmtest/internal/mockgen"
+	. "gopkg.in/check.v1"
+)
+
+func TestMocks(t *testing.T) { TestingT(t) }
+
+type MocksSuite struct {
+}
+
+var _ = Suite(&MocksSuite{})
+
+func (s *MocksSuite) TestGenerated_UpToDate(c *C) {
+	expected, err := mockgen.Generate("..")
+	c.Assert(err, IsNil)
+
+	actual, err := os.ReadFile("mocks_gen.go")
+	c.Assert(err, IsNil)
+	c.Assert(string(actual) == string(expected), Equals, true, Commentf("mocks_gen.go is out of date, run go generate"))
+}
+
+func (s *MocksSuite) TestMock_Func(c *C) {
+	mock := &DealsServiceMock{
+		GetFunc: func(id int) (*basecrm.Deal, *basecrm.Response, error) {
+			return &basecrm.Deal{Id: id, Name: "Website Redesign"}, nil, nil
+		},
+		DeleteContextFunc: func(ctx context.Context, id int) (bool, *basecrm.Response, error) {
+			return false, nil, basecrm.ErrNotFound
+		},
+	}
+
+	deal, _, err := mock.Get(1)
+	c.Assert(err, IsNil)
+	c.Assert(deal.Name, Equals, "Website Redesign")
+
+	// methods without a context use the function of their context variant
+	_, _, err = mock.Delete(2)
+	c.Assert(err, Equals, basecrm.ErrNotFound)
+
+	// methods without a function return zero values
+	deals, _, err := mock.List(nil)
+	c.Assert(err, IsNil)
+	c.Assert(deals, IsNil)
+
+	c.Assert(mock.Calls(), DeepEquals, []Call{
+		{Method: "Get", Args: []interface{}{1}},
+		{Method: "Delete", Args: []interface{}{2}},
+		{Method: "List", Args: []interface{}{(*basecrm.DealListOptions)(nil)}},
+	})
+	c.Assert(mock.CallsTo("Delete"), HasLen, 1)
+
+	mock.ResetCalls()
+	c.Assert(mock.Calls(), HasLen, 0)
+}
+
+func (s *MocksSuite) TestMock_ListAll(c *C) {
+	mock := &TagsServiceMock{}
+
+	tags, err := mock.ListAll(nil).All()
+	c.Assert(err, IsNil)
+	c.Assert(tags, HasLen, 0)
+
+	mock.ListAllFunc = func(opt *basecrm.TagListOptions) *basecrm.Iter[*basecrm.Tag] {
+		return IterOf(&basecrm.Tag{Id: 1}, &basecrm.Tag{Id: 2})
+	}
+	tags, err = mock.ListAll(nil).All()
+	c.Assert(err, IsNil)
+	c.Assert(tags, HasLen, 2)
+
+	failure := errors.New("failure")
+	mock.ListAllFunc = func(opt *basecrm.TagListOptions) *basecrm.Iter[*basecrm.Tag] {
+		return IterErr[*basecrm.Tag](failure)
+	}
+	_, err = mock.ListAll(nil).All()
+	c.Assert(err, Equals, failure)
+}
+
+func (s *MocksSuite) TestNewMockClient(c *C) {
+	client, mocks := NewMockClient()
+
+	mocks.Users.SelfFunc = func() (*basecrm.User, *basecrm.Response, error) {
+		return &basecrm.User{Id: 1, Name: "Mark"}, nil, nil
+	}
+
+	user, _, err := client.Users.Self()
+	c.Assert(err, IsNil)
+	c.Assert(user.Name, Equals, "Mark")
+	c.Assert(mocks.Users.CallsTo("Self"), HasLen, 1)
+	c.Assert(client.Deals, Equals, basecrm.DealsService(mocks.Deals))
+}
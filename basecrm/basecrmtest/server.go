@@ -0,0 +1,674 @@
+// Package basecrmtest provides an in-memory fake of the BaseCRM API for tests.
+//
+// The fake server keeps deals, contacts, leads, notes, tasks, tags, sources,
+// loss reasons and users in memory and serves them with the envelopes, filtering,
+// pagination links and error responses of the real API:
+//
+//	srv := basecrmtest.NewServer()
+//	defer srv.Close()
+//
+//	client := srv.Client()
+//	deal, _, err := client.Deals.Create(&basecrm.Deal{Name: "Website Redesign"})
+package basecrmtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/iaintshine/basecrm-go/basecrm"
+)
+
+// Resources served by the fake server.
+const (
+	Deals       = "deals"
+	Contacts    = "contacts"
+	Leads       = "leads"
+	Notes       = "notes"
+	Tasks       = "tasks"
+	Tags        = "tags"
+	Sources     = "sources"
+	LossReasons = "loss_reasons"
+	Users       = "users"
+)
+
+// types maps resources to the types in their envelopes.
+var types = map[string]string{
+	Deals:       "deal",
+	Contacts:    "contact",
+	Leads:       "lead",
+	Notes:       "note",
+	Tasks:       "task",
+	Tags:        "tag",
+	Sources:     "source",
+	LossReasons: "loss_reason",
+	Users:       "user",
+}
+
+// readOnly lists resources which can not be created, edited or deleted through the API.
+var readOnly = map[string]bool{
+	Users: true,
+}
+
+// Attributes managed by the server.
+var serverAttributes = []string{"id", "creator_id", "created_at", "updated_at"}
+
+const (
+	defaultPerPage = 25
+	maxPerPage     = 100
+)
+
+type record map[string]interface{}
+
+// Server is a fake BaseCRM API server.
+type Server struct {
+	// URL of the server, e.g. http://127.0.0.1:1234.
+	URL string
+	// Token required in the Authorization header. When empty, requests are not authenticated.
+	Token string
+	// Id of the user returned by /v2/users/self and set as the creator of new resources.
+	SelfId int
+
+	server *httptest.Server
+
+	mu        sync.Mutex
+	records   map[string]map[int]record
+	nextId    map[string]int
+	requestId int
+}
+
+// NewServer starts a fake server with a single admin user and no other resources.
+func NewServer() *Server {
+	s := &Server{}
+	s.Reset()
+	s.server = httptest.NewServer(s)
+	s.URL = s.server.URL
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Client returns a client sending requests to the server.
+func (s *Server) Client() *basecrm.Client {
+	client := basecrm.NewClient(&http.Client{Transport: &tokenTransport{s}})
+	client.BaseURL, _ = url.Parse(s.URL)
+	return client
+}
+
+type tokenTransport struct {
+	server *Server
+}
+
+func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.server.Token != "" {
+		req = req.Clone(req.Context())
+		req.Header.Set("Authorization", "Bearer "+t.server.Token)
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// Reset removes all resources and recreates the admin user.
+func (s *Server) Reset() {
+	admin, _ := toRecord(&basecrm.User{
+		Name:      "Admin",
+		Email:     "admin@example.com",
+		Role:      "admin",
+		Status:    "active",
+		Confirmed: true,
+	})
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.records = map[string]map[int]record{}
+	s.nextId = map[string]int{}
+	for resource := range types {
+		s.records[resource] = map[int]record{}
+	}
+	s.SelfId = s.create(Users, admin)
+}
+
+// Add stores v, e.g. a *basecrm.Deal, as a new resource and returns its id.
+// Unlike the API, it accepts every resource, including users.
+func (s *Server) Add(resource string, v interface{}) (int, error) {
+	r, err := toRecord(v)
+	if err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.records[resource]; !ok {
+		return 0, fmt.Errorf("basecrmtest: unknown resource %q", resource)
+	}
+	return s.create(resource, r), nil
+}
+
+// Get decodes the resource with the given id into v, e.g. a *basecrm.Deal.
+// It reports whether the resource exists.
+func (s *Server) Get(resource string, id int, v interface{}) bool {
+	s.mu.Lock()
+	r, ok := s.records[resource][id]
+	s.mu.Unlock()
+
+	if !ok {
+		return false
+	}
+
+	data, err := json.Marshal(r)
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(data, v) == nil
+}
+
+// Count returns the number of stored resources.
+func (s *Server) Count(resource string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.records[resource])
+}
+
+func toRecord(v interface{}) (record, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	r := record{}
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("basecrmtest: %T is not an object", v)
+	}
+	return r, nil
+}
+
+// create stores the record under a new id. It must be called with the lock held.
+func (s *Server) create(resource string, r record) int {
+	s.nextId[resource]++
+	id := s.nextId[resource]
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	r["id"] = id
+	if resource != Users {
+		r["creator_id"] = s.SelfId
+	}
+	r["created_at"] = now
+	r["updated_at"] = now
+
+	s.records[resource][id] = r
+	return id
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requestId++
+	w.Header().Set("X-Request-Id", strconv.Itoa(s.requestId))
+	w.Header().Set("Content-Type", "application/json")
+
+	if s.Token != "" && req.Header.Get("Authorization") != "Bearer "+s.Token {
+		s.writeError(w, http.StatusUnauthorized, &basecrm.Error{Code: "unauthorized", Message: "Invalid access token"})
+		return
+	}
+
+	parts := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] != "v2" {
+		s.notFound(w)
+		return
+	}
+
+	resource := parts[1]
+	if resource == "accounts" && len(parts) == 3 && parts[2] == "self" && req.Method == "GET" {
+		s.writeData(w, "account", record{"id": 1, "name": "Fake Account", "currency": "USD", "time_format": "24H", "timezone": "UTC+00:00"})
+		return
+	}
+
+	if _, ok := s.records[resource]; !ok {
+		s.notFound(w)
+		return
+	}
+
+	if len(parts) == 2 {
+		switch req.Method {
+		case "GET":
+			s.list(w, req, resource)
+		case "POST":
+			s.createResource(w, req, resource)
+		default:
+			s.methodNotAllowed(w)
+		}
+		return
+	}
+
+	var id int
+	if resource == Users && parts[2] == "self" {
+		id = s.SelfId
+	} else {
+		var err error
+		if id, err = strconv.Atoi(parts[2]); err != nil {
+			s.notFound(w)
+			return
+		}
+	}
+
+	r, ok := s.records[resource][id]
+	if !ok {
+		s.notFound(w)
+		return
+	}
+
+	switch req.Method {
+	case "GET":
+		s.writeData(w, types[resource], r)
+	case "PUT":
+		s.update(w, req, resource, id, r)
+	case "DELETE":
+		if readOnly[resource] {
+			s.methodNotAllowed(w)
+			return
+		}
+		delete(s.records[resource], id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		s.methodNotAllowed(w)
+	}
+}
+
+func (s *Server) list(w http.ResponseWriter, req *http.Request, resource string) {
+	query := req.URL.Query()
+
+	page, perPage := 1, defaultPerPage
+	if v, err := strconv.Atoi(query.Get("page")); err == nil && v > 0 {
+		page = v
+	}
+	if v, err := strconv.Atoi(query.Get("per_page")); err == nil && v > 0 {
+		perPage = v
+	}
+	if perPage > maxPerPage {
+		s.writeError(w, http.StatusUnprocessableEntity, &basecrm.Error{
+			Field: "per_page", Code: "invalid", Message: fmt.Sprintf("per_page must be less than or equal to %d", maxPerPage),
+		})
+		return
+	}
+
+	var matches []record
+	for _, r := range s.records[resource] {
+		if matchesQuery(r, query) {
+			matches = append(matches, r)
+		}
+	}
+
+	sortRecords(matches, query.Get("sort_by"))
+
+	start := (page - 1) * perPage
+	end := start + perPage
+	if start > len(matches) {
+		start = len(matches)
+	}
+	if end > len(matches) {
+		end = len(matches)
+	}
+
+	items := make([]interface{}, 0, end-start)
+	for _, r := range matches[start:end] {
+		items = append(items, &envelope{Data: r, Meta: &basecrm.Meta{Type: types[resource]}})
+	}
+
+	links := &basecrm.Links{
+		Self:  s.pageURL(req, page),
+		First: s.pageURL(req, 1),
+	}
+	if page > 1 {
+		links.Prev = s.pageURL(req, page-1)
+	}
+	if end < len(matches) {
+		links.Next = s.pageURL(req, page+1)
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"items": items,
+		"meta":  &basecrm.Meta{Type: "collection", Count: len(items), Links: links},
+	})
+}
+
+func (s *Server) pageURL(req *http.Request, page int) string {
+	query := req.URL.Query()
+	query.Set("page", strconv.Itoa(page))
+	return s.URL + req.URL.Path + "?" + query.Encode()
+}
+
+func (s *Server) createResource(w http.ResponseWriter, req *http.Request, resource string) {
+	if readOnly[resource] {
+		s.methodNotAllowed(w)
+		return
+	}
+
+	r, ok := s.readData(w, req)
+	if !ok {
+		return
+	}
+
+	if _, ok := r["owner_id"]; !ok && hasOwner(resource) {
+		r["owner_id"] = s.SelfId
+	}
+
+	if errs := validate(resource, r); len(errs) > 0 {
+		s.writeError(w, http.StatusUnprocessableEntity, errs...)
+		return
+	}
+
+	id := s.create(resource, r)
+	s.writeData(w, types[resource], s.records[resource][id])
+}
+
+func (s *Server) update(w http.ResponseWriter, req *http.Request, resource string, id int, r record) {
+	if readOnly[resource] {
+		s.methodNotAllowed(w)
+		return
+	}
+
+	changes, ok := s.readData(w, req)
+	if !ok {
+		return
+	}
+
+	updated := record{}
+	for k, v := range r {
+		updated[k] = v
+	}
+	for k, v := range changes {
+		updated[k] = v
+	}
+
+	if errs := validate(resource, updated); len(errs) > 0 {
+		s.writeError(w, http.StatusUnprocessableEntity, errs...)
+		return
+	}
+
+	updated["updated_at"] = time.Now().UTC().Format(time.RFC3339)
+	s.records[resource][id] = updated
+	s.writeData(w, types[resource], updated)
+}
+
+// readData decodes the data envelope of the request, without the attributes managed by the server.
+func (s *Server) readData(w http.ResponseWriter, req *http.Request) (record, bool) {
+	var body struct {
+		Data record `json:"data"`
+	}
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil || body.Data == nil {
+		s.writeError(w, http.StatusBadRequest, &basecrm.Error{Code: "invalid_json", Message: "Request body must be a JSON object with a data attribute"})
+		return nil, false
+	}
+
+	for _, attribute := range serverAttributes {
+		delete(body.Data, attribute)
+	}
+	return body.Data, true
+}
+
+func hasOwner(resource string) bool {
+	switch resource {
+	case Deals, Contacts, Leads, Tasks:
+		return true
+	}
+	return false
+}
+
+// validate returns the errors of the required attributes missing in the record.
+func validate(resource string, r record) []*basecrm.Error {
+	var errs []*basecrm.Error
+	require := func(attributes ...string) {
+		for _, attribute := range attributes {
+			if isBlank(r[attribute]) {
+				errs = append(errs, &basecrm.Error{
+					Resource: types[resource],
+					Field:    attribute,
+					Code:     "blank",
+					Message:  "can't be blank",
+				})
+			}
+		}
+	}
+
+	switch resource {
+	case Deals, Sources, LossReasons:
+		require("name")
+	case Contacts:
+		if r["is_organization"] == true {
+			require("name")
+		} else {
+			require("last_name")
+		}
+	case Leads:
+		if isBlank(r["last_name"]) {
+			require("organization_name")
+		}
+	case Notes:
+		require("resource_type", "resource_id", "content")
+	case Tasks:
+		require("content")
+	case Tags:
+		require("name", "resource_type")
+	}
+	return errs
+}
+
+func isBlank(v interface{}) bool {
+	switch value := v.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(value) == ""
+	}
+	return false
+}
+
+type envelope struct {
+	Data record        `json:"data"`
+	Meta *basecrm.Meta `json:"meta"`
+}
+
+func (s *Server) writeData(w http.ResponseWriter, resourceType string, r record) {
+	writeJSON(w, http.StatusOK, &envelope{Data: r, Meta: &basecrm.Meta{Type: resourceType}})
+}
+
+func (s *Server) notFound(w http.ResponseWriter) {
+	s.writeError(w, http.StatusNotFound, &basecrm.Error{Code: "not_found", Message: "The requested resource could not be found"})
+}
+
+func (s *Server) methodNotAllowed(w http.ResponseWriter) {
+	s.writeError(w, http.StatusMethodNotAllowed, &basecrm.Error{Code: "method_not_allowed", Message: "The method is not allowed for the resource"})
+}
+
+// writeError writes an error envelope.
+func (s *Server) writeError(w http.ResponseWriter, status int, errs ...*basecrm.Error) {
+	envelope := &basecrm.ErrorsEnvelope{
+		Meta: &basecrm.ErrorsMeta{
+			Type:       "errors",
+			HttpStatus: fmt.Sprintf("%d %s", status, http.StatusText(status)),
+			Logref:     strconv.Itoa(s.requestId),
+		},
+	}
+	for _, err := range errs {
+		envelope.Errors = append(envelope.Errors, &basecrm.ErrorEnvelope{
+			Error: err,
+			Meta:  &basecrm.ErrorMeta{Type: "error"},
+		})
+	}
+	writeJSON(w, status, envelope)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// matchesQuery reports whether the record matches the filters of the query.
+// Pagination and sorting parameters are ignored.
+func matchesQuery(r record, query url.Values) bool {
+	for param, values := range query {
+		value := values[0]
+		switch param {
+		case "page", "per_page", "sort_by":
+			continue
+		case "ids":
+			if !containsString(strings.Split(value, ","), format(r["id"])) {
+				return false
+			}
+		case "q":
+			if !matchesText(r, value) {
+				return false
+			}
+		case "letter":
+			name := firstNonBlank(r["last_name"], r["name"], r["organization_name"])
+			if !strings.HasPrefix(strings.ToLower(name), strings.ToLower(value)) {
+				return false
+			}
+		default:
+			if format(lookup(r, param)) != value {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// lookup returns the value of an attribute, nested attributes use brackets, e.g. address[city].
+func lookup(r record, param string) interface{} {
+	name, rest, nested := strings.Cut(param, "[")
+	if !nested {
+		return r[name]
+	}
+
+	inner, ok := r[name].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return lookup(inner, strings.Replace(rest, "]", "", 1))
+}
+
+func matchesText(r record, text string) bool {
+	text = strings.ToLower(text)
+	for _, attribute := range []string{"name", "first_name", "last_name", "organization_name", "email", "content"} {
+		if s, ok := r[attribute].(string); ok && strings.Contains(strings.ToLower(s), text) {
+			return true
+		}
+	}
+	return false
+}
+
+func firstNonBlank(values ...interface{}) string {
+	for _, v := range values {
+		if !isBlank(v) {
+			return format(v)
+		}
+	}
+	return ""
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if strings.TrimSpace(v) == s {
+			return true
+		}
+	}
+	return false
+}
+
+// format formats a JSON value like a query parameter.
+func format(v interface{}) string {
+	switch value := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case int:
+		return strconv.Itoa(value)
+	case bool:
+		return strconv.FormatBool(value)
+	}
+	return fmt.Sprint(v)
+}
+
+// sortRecords sorts the records by id, or by the attributes of the sort_by parameter,
+// e.g. name:asc,created_at:desc.
+func sortRecords(records []record, sortBy string) {
+	type order struct {
+		attribute string
+		desc      bool
+	}
+
+	orders := []order{}
+	for _, field := range strings.Split(sortBy, ",") {
+		if field = strings.TrimSpace(field); field == "" {
+			continue
+		}
+		attribute, direction, _ := strings.Cut(field, ":")
+		orders = append(orders, order{attribute, direction == "desc"})
+	}
+	orders = append(orders, order{attribute: "id"})
+
+	sort.SliceStable(records, func(i, j int) bool {
+		for _, o := range orders {
+			c := compare(records[i][o.attribute], records[j][o.attribute])
+			if c == 0 {
+				continue
+			}
+			if o.desc {
+				return c > 0
+			}
+			return c < 0
+		}
+		return false
+	})
+}
+
+// compare orders numbers numerically and other values by their formatted value.
+// Missing values are ordered last.
+func compare(a, b interface{}) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	}
+
+	if x, ok := number(a); ok {
+		if y, ok := number(b); ok {
+			switch {
+			case x < y:
+				return -1
+			case x > y:
+				return 1
+			}
+			return 0
+		}
+	}
+	return strings.Compare(format(a), format(b))
+}
+
+func number(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	}
+	return 0, false
+}
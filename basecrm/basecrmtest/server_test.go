@@ -0,0 +1,180 @@
+package basecrmtest
+
+import (
+	"testing"
+
+	"github.com/iaintshine/basecrm-go/basecrm"
+	. "gopkg.in/check.v1"
+)
+
+func TestServer(t *testing.T) { TestingT(t) }
+
+type ServerSuite struct {
+	server *Server
+	client *basecrm.Client
+}
+
+var _ = Suite(&ServerSuite{})
+
+func (s *ServerSuite) SetUpTest(c *C) {
+	s.server = NewServer()
+	s.client = s.server.Client()
+}
+
+func (s *ServerSuite) TearDownTest(c *C) {
+	s.server.Close()
+}
+
+func (s *ServerSuite) TestCRUD(c *C) {
+	deal, _, err := s.client.Deals.Create(&basecrm.Deal{Name: "Website Redesign", Hot: true, Tags: []string{"web"}})
+	c.Assert(err, IsNil)
+	c.Assert(deal.Id, Equals, 1)
+	c.Assert(deal.CreatorId, Equals, s.server.SelfId)
+	c.Assert(deal.OwnerId, Equals, s.server.SelfId)
+	c.Assert(deal.CreatedAt.IsZero(), Equals, false)
+
+	deal, _, err = s.client.Deals.Get(1)
+	c.Assert(err, IsNil)
+	c.Assert(deal.Name, Equals, "Website Redesign")
+	c.Assert(deal.Tags, DeepEquals, []string{"web"})
+
+	deal, _, err = s.client.Deals.Edit(1, &basecrm.Deal{Name: "Website Hosting"})
+	c.Assert(err, IsNil)
+	c.Assert(deal.Name, Equals, "Website Hosting")
+	c.Assert(deal.Hot, Equals, true)
+
+	var stored basecrm.Deal
+	c.Assert(s.server.Get(Deals, 1, &stored), Equals, true)
+	c.Assert(stored.Name, Equals, "Website Hosting")
+
+	deleted, _, err := s.client.Deals.Delete(1)
+	c.Assert(err, IsNil)
+	c.Assert(deleted, Equals, true)
+	c.Assert(s.server.Count(Deals), Equals, 0)
+
+	_, _, err = s.client.Deals.Get(1)
+	c.Assert(basecrm.IsNotFound(err), Equals, true)
+}
+
+func (s *ServerSuite) TestValidation(c *C) {
+	_, _, err := s.client.Contacts.Create(&basecrm.Contact{FirstName: "Mark"})
+	c.Assert(basecrm.IsValidation(err), Equals, true)
+
+	var verr *basecrm.ValidationError
+	c.Assert(err, FitsTypeOf, verr)
+	verr = err.(*basecrm.ValidationError)
+	c.Assert(verr.Field("last_name"), HasLen, 1)
+	c.Assert(verr.Field("last_name")[0].Code, Equals, "blank")
+
+	_, _, err = s.client.Contacts.Create(&basecrm.Contact{IsOrganization: true, Name: "Design Services Company"})
+	c.Assert(err, IsNil)
+
+	_, _, err = s.client.Leads.Create(&basecrm.Lead{OrganizationName: "Design Services Company"})
+	c.Assert(err, IsNil)
+}
+
+func (s *ServerSuite) TestList_FilterAndPaginate(c *C) {
+	for _, name := range []string{"Johnson", "Doe", "Smith", "Jackson", "Brown"} {
+		_, err := s.server.Add(Contacts, &basecrm.Contact{LastName: name, Address: &basecrm.Address{City: "Hyannis"}})
+		c.Assert(err, IsNil)
+	}
+	_, err := s.server.Add(Contacts, &basecrm.Contact{LastName: "Jones", Address: &basecrm.Address{City: "Boston"}})
+	c.Assert(err, IsNil)
+
+	opt := &basecrm.ContactListOptions{City: "Hyannis", ListOptions: basecrm.ListOptions{PerPage: 2, SortBy: []string{"last_name:asc"}}}
+	contacts, res, err := s.client.Contacts.List(opt)
+	c.Assert(err, IsNil)
+	c.Assert(contacts, HasLen, 2)
+	c.Assert(contacts[0].LastName, Equals, "Brown")
+	c.Assert(contacts[1].LastName, Equals, "Doe")
+	c.Assert(res.Meta.Count, Equals, 2)
+	c.Assert(res.NextPage, Equals, 2)
+	c.Assert(res.PrevPage, Equals, 0)
+
+	all, err := s.client.Contacts.ListAll(opt).All()
+	c.Assert(err, IsNil)
+	names := make([]string, len(all))
+	for i, contact := range all {
+		names[i] = contact.LastName
+	}
+	c.Assert(names, DeepEquals, []string{"Brown", "Doe", "Jackson", "Johnson", "Smith"})
+
+	contacts, _, err = s.client.Contacts.List(&basecrm.ContactListOptions{Q: "jo"})
+	c.Assert(err, IsNil)
+	c.Assert(contacts, HasLen, 2)
+
+	contacts, _, err = s.client.Contacts.List(&basecrm.ContactListOptions{ListOptions: basecrm.ListOptions{Ids: []int{2, 6}}})
+	c.Assert(err, IsNil)
+	c.Assert(contacts, HasLen, 2)
+	c.Assert(contacts[1].LastName, Equals, "Jones")
+}
+
+func (s *ServerSuite) TestList_DealsByContact(c *C) {
+	_, err := s.server.Add(Deals, &basecrm.Deal{Name: "Website Redesign", ContactId: 1})
+	c.Assert(err, IsNil)
+	_, err = s.server.Add(Deals, &basecrm.Deal{Name: "Website Hosting", ContactId: 2})
+	c.Assert(err, IsNil)
+
+	deals, _, err := s.client.Deals.List(&basecrm.DealListOptions{ContactId: 2})
+	c.Assert(err, IsNil)
+	c.Assert(deals, HasLen, 1)
+	c.Assert(deals[0].Name, Equals, "Website Hosting")
+}
+
+func (s *ServerSuite) TestReset_WhileServing(c *C) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 10; i++ {
+			s.client.Deals.Create(&basecrm.Deal{Name: "Website Redesign"})
+		}
+	}()
+	for i := 0; i < 10; i++ {
+		s.server.Reset()
+	}
+	<-done
+
+	c.Assert(s.server.Count(Users), Equals, 1)
+}
+
+func (s *ServerSuite) TestUsers(c *C) {
+	self, _, err := s.client.Users.Self()
+	c.Assert(err, IsNil)
+	c.Assert(self.Id, Equals, s.server.SelfId)
+	c.Assert(self.Role, Equals, "admin")
+
+	_, err = s.server.Add(Users, &basecrm.User{Name: "Sales", Role: "user"})
+	c.Assert(err, IsNil)
+
+	users, _, err := s.client.Users.List(nil)
+	c.Assert(err, IsNil)
+	c.Assert(users, HasLen, 2)
+
+	account, _, err := s.client.Accounts.Self()
+	c.Assert(err, IsNil)
+	c.Assert(account.Name, Equals, "Fake Account")
+}
+
+func (s *ServerSuite) TestToken(c *C) {
+	s.server.Token = "secret"
+
+	_, _, err := s.client.Users.Self()
+	c.Assert(err, IsNil)
+
+	client := basecrm.NewClient(nil)
+	client.BaseURL = s.client.BaseURL
+	_, _, err = client.Users.Self()
+	c.Assert(basecrm.IsUnauthorized(err), Equals, true)
+}
+
+func (s *ServerSuite) TestNotFound(c *C) {
+	_, _, err := s.client.Tags.Get(42)
+	c.Assert(basecrm.IsNotFound(err), Equals, true)
+
+	var apiErr *basecrm.ErrorResponse
+	c.Assert(err, FitsTypeOf, apiErr)
+	c.Assert(err.(*basecrm.ErrorResponse).RequestId, Not(Equals), "")
+
+	_, err = s.server.Add("unknown", &basecrm.Tag{})
+	c.Assert(err, ErrorMatches, `basecrmtest: unknown resource "unknown"`)
+}
@@ -0,0 +1,87 @@
+package bulk
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/iaintshine/basecrm-go/basecrm"
+)
+
+// Exporter writes all contacts, leads or deals as CSV.
+type Exporter struct {
+	Client *basecrm.Client
+	// One of basecrm.ContactResource, basecrm.LeadResource or basecrm.DealResource.
+	Resource basecrm.ResourceType
+	// Columns to export. Columns without a header use the field name as the header.
+	Columns []Column
+}
+
+// Export lists all resources and writes them to w, one row per resource.
+func (ex *Exporter) Export(ctx context.Context, w io.Writer) error {
+	if len(ex.Columns) == 0 {
+		return errors.New("bulk: no columns to export")
+	}
+
+	writer := csv.NewWriter(w)
+
+	header := make([]string, len(ex.Columns))
+	for i, column := range ex.Columns {
+		header[i] = column.Header
+		if header[i] == "" {
+			header[i] = column.Field
+		}
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	var err error
+	switch ex.Resource {
+	case basecrm.ContactResource:
+		err = exportAll(ex, writer, ex.Client.Contacts.ListAllContext(ctx, nil))
+	case basecrm.LeadResource:
+		err = exportAll(ex, writer, ex.Client.Leads.ListAllContext(ctx, nil))
+	case basecrm.DealResource:
+		err = exportAll(ex, writer, ex.Client.Deals.ListAllContext(ctx, nil))
+	default:
+		err = fmt.Errorf("bulk: unsupported resource %q", ex.Resource)
+	}
+	if err != nil {
+		return err
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func exportAll[T any](ex *Exporter, writer *csv.Writer, it *basecrm.Iter[T]) error {
+	for it.Next() {
+		record, err := ex.encode(it.Value())
+		if err != nil {
+			return err
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	return it.Err()
+}
+
+// encode formats the columns of a single resource.
+func (ex *Exporter) encode(resource interface{}) ([]string, error) {
+	v := reflect.Indirect(reflect.ValueOf(resource))
+
+	record := make([]string, len(ex.Columns))
+	for i, column := range ex.Columns {
+		value, err := getField(v, column.Field)
+		if err != nil {
+			return nil, fmt.Errorf("bulk: %w", err)
+		}
+		record[i] = value
+	}
+	return record, nil
+}
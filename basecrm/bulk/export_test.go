@@ -0,0 +1,78 @@
+package bulk
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/iaintshine/basecrm-go/basecrm"
+	. "gopkg.in/check.v1"
+)
+
+func TestExporter(t *testing.T) { TestingT(t) }
+
+type ExporterSuite struct {
+}
+
+var _ = Suite(&ExporterSuite{})
+
+func (s *ExporterSuite) TestExport_Leads(c *C) {
+	setup()
+	defer teardown()
+
+	pages := map[string]string{
+		"1": `{"items": [
+      {"data": {"id": 1, "last_name": "Johnson", "address": {"city": "Hyannis"}, "tags": ["vip", "web"]}},
+      {"data": {"id": 2, "organization_name": "Design Services Company", "custom_fields": {"Employees": 250}}}],
+      "meta": {"type": "collection", "links": {"next_page": "https://api.getbase.com/v2/leads?page=2"}}}`,
+		"2": `{"items": [{"data": {"id": 3, "last_name": "Doe, Jr."}}], "meta": {"type": "collection", "links": {}}}`,
+	}
+	mux.HandleFunc("/v2/leads", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, pages[req.URL.Query().Get("page")])
+	})
+
+	ex := &Exporter{
+		Client:   client,
+		Resource: basecrm.LeadResource,
+		Columns: []Column{
+			{Field: "id"},
+			{Header: "Name", Field: "last_name"},
+			{Header: "Company", Field: "organization_name"},
+			{Header: "City", Field: "address.city"},
+			{Header: "Tags", Field: "tags"},
+			{Header: "Employees", Field: "custom_fields.Employees"},
+		},
+	}
+
+	var out bytes.Buffer
+	c.Assert(ex.Export(context.Background(), &out), IsNil)
+	c.Assert(out.String(), Equals, `id,Name,Company,City,Tags,Employees
+1,Johnson,,Hyannis,"vip,web",
+2,,Design Services Company,,,250
+3,"Doe, Jr.",,,,
+`)
+}
+
+func (s *ExporterSuite) TestExport_Errors(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/deals", func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	var out bytes.Buffer
+	ex := &Exporter{Client: client, Resource: basecrm.DealResource}
+	c.Assert(ex.Export(context.Background(), &out), ErrorMatches, "bulk: no columns to export")
+
+	ex.Columns = []Column{{Field: "name"}}
+	c.Assert(basecrm.IsServerError(ex.Export(context.Background(), &out)), Equals, true)
+
+	ex = &Exporter{Client: client, Resource: basecrm.ContactResource, Columns: []Column{{Field: "unknown"}}}
+	mux.HandleFunc("/v2/contacts", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"items": [{"data": {"id": 1}}], "meta": {"type": "collection"}}`)
+	})
+	c.Assert(ex.Export(context.Background(), &out), ErrorMatches, `bulk: unknown field "unknown"`)
+}
@@ -0,0 +1,199 @@
+package bulk
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// listSeparator separates the elements of list values, such as tags, in a single CSV cell.
+const listSeparator = ","
+
+// Column maps a CSV column to a field of a Contact, Lead or Deal.
+//
+// Fields are named after their JSON names. Nested fields are separated by dots,
+// e.g. address.city, and custom fields are prefixed with custom_fields, e.g. custom_fields.Industry.
+type Column struct {
+	Header string
+	Field  string
+}
+
+// setField parses raw and stores it in the field of the struct v. Empty values are skipped.
+func setField(v reflect.Value, path, raw string) error {
+	if raw == "" {
+		return nil
+	}
+
+	name, rest, nested := strings.Cut(path, ".")
+	f, ok := fieldByName(v, name)
+	if !ok {
+		return fmt.Errorf("unknown field %q", path)
+	}
+
+	if !nested {
+		if err := setValue(f, raw); err != nil {
+			return fmt.Errorf("field %q: %w", path, err)
+		}
+		return nil
+	}
+
+	switch f.Kind() {
+	case reflect.Ptr:
+		if f.Type().Elem().Kind() != reflect.Struct {
+			break
+		}
+		if f.IsNil() {
+			f.Set(reflect.New(f.Type().Elem()))
+		}
+		return setField(f.Elem(), rest, raw)
+	case reflect.Struct:
+		return setField(f, rest, raw)
+	case reflect.Map:
+		if f.Type().Key().Kind() != reflect.String {
+			break
+		}
+		if f.IsNil() {
+			f.Set(reflect.MakeMap(f.Type()))
+		}
+		f.SetMapIndex(reflect.ValueOf(rest).Convert(f.Type().Key()), reflect.ValueOf(raw))
+		return nil
+	}
+
+	return fmt.Errorf("unknown field %q", path)
+}
+
+func setValue(f reflect.Value, raw string) error {
+	if u, ok := f.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(raw))
+	}
+
+	switch f.Kind() {
+	case reflect.String:
+		f.SetString(raw)
+	case reflect.Int, reflect.Int64, reflect.Int32:
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%q is not an integer", raw)
+		}
+		f.SetInt(n)
+	case reflect.Float64, reflect.Float32:
+		n, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return fmt.Errorf("%q is not a number", raw)
+		}
+		f.SetFloat(n)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("%q is not a boolean", raw)
+		}
+		f.SetBool(b)
+	case reflect.Slice:
+		if f.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported type %s", f.Type())
+		}
+		f.Set(reflect.ValueOf(splitList(raw)).Convert(f.Type()))
+	default:
+		return fmt.Errorf("unsupported type %s", f.Type())
+	}
+	return nil
+}
+
+// getField formats the field of the struct v for a CSV cell.
+func getField(v reflect.Value, path string) (string, error) {
+	name, rest, nested := strings.Cut(path, ".")
+	f, ok := fieldByName(v, name)
+	if !ok {
+		return "", fmt.Errorf("unknown field %q", path)
+	}
+
+	if !nested {
+		return formatValue(f), nil
+	}
+
+	switch f.Kind() {
+	case reflect.Ptr:
+		if f.Type().Elem().Kind() != reflect.Struct {
+			break
+		}
+		if f.IsNil() {
+			return "", nil
+		}
+		return getField(f.Elem(), rest)
+	case reflect.Struct:
+		return getField(f, rest)
+	case reflect.Map:
+		if f.Type().Key().Kind() != reflect.String {
+			break
+		}
+		value := f.MapIndex(reflect.ValueOf(rest).Convert(f.Type().Key()))
+		if !value.IsValid() {
+			return "", nil
+		}
+		return formatValue(value), nil
+	}
+
+	return "", fmt.Errorf("unknown field %q", path)
+}
+
+func formatValue(f reflect.Value) string {
+	for f.Kind() == reflect.Interface || f.Kind() == reflect.Ptr {
+		if f.IsNil() {
+			return ""
+		}
+		f = f.Elem()
+	}
+
+	// zero values are left empty, like the omitted fields of the API
+	if f.IsZero() && f.Kind() != reflect.Bool {
+		return ""
+	}
+
+	if m, ok := f.Interface().(encoding.TextMarshaler); ok {
+		text, err := m.MarshalText()
+		if err != nil {
+			return ""
+		}
+		return string(text)
+	}
+
+	switch f.Kind() {
+	case reflect.String:
+		return f.String()
+	case reflect.Int, reflect.Int64, reflect.Int32:
+		return strconv.FormatInt(f.Int(), 10)
+	case reflect.Float64, reflect.Float32:
+		return strconv.FormatFloat(f.Float(), 'f', -1, 64)
+	case reflect.Bool:
+		return strconv.FormatBool(f.Bool())
+	case reflect.Slice:
+		values := make([]string, f.Len())
+		for i := range values {
+			values[i] = formatValue(f.Index(i))
+		}
+		return strings.Join(values, listSeparator)
+	}
+	return fmt.Sprint(f.Interface())
+}
+
+// fieldByName returns the field of the struct v with the given JSON name.
+func fieldByName(v reflect.Value, name string) (reflect.Value, bool) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		tag, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if tag == name && t.Field(i).IsExported() {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+func splitList(raw string) []string {
+	values := strings.Split(raw, listSeparator)
+	for i, value := range values {
+		values[i] = strings.TrimSpace(value)
+	}
+	return values
+}
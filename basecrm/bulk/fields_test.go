@@ -0,0 +1,81 @@
+package bulk
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/iaintshine/basecrm-go/basecrm"
+	. "gopkg.in/check.v1"
+)
+
+func TestFields(t *testing.T) { TestingT(t) }
+
+type FieldsSuite struct {
+}
+
+var _ = Suite(&FieldsSuite{})
+
+func (s *FieldsSuite) TestSetField(c *C) {
+	contact := new(basecrm.Contact)
+	v := reflect.ValueOf(contact).Elem()
+
+	c.Assert(setField(v, "first_name", "Mark"), IsNil)
+	c.Assert(setField(v, "owner_id", "12"), IsNil)
+	c.Assert(setField(v, "is_organization", "false"), IsNil)
+	c.Assert(setField(v, "tags", "vip, important"), IsNil)
+	c.Assert(setField(v, "address.city", "Hyannis"), IsNil)
+	c.Assert(setField(v, "custom_fields.Industry", "IT"), IsNil)
+	c.Assert(setField(v, "created_at", "2014-08-27T16:32:56Z"), IsNil)
+	c.Assert(setField(v, "last_name", ""), IsNil)
+
+	c.Assert(contact.FirstName, Equals, "Mark")
+	c.Assert(contact.OwnerId, Equals, 12)
+	c.Assert(contact.Tags, DeepEquals, []string{"vip", "important"})
+	c.Assert(contact.Address, DeepEquals, &basecrm.Address{City: "Hyannis"})
+	c.Assert(contact.CustomFields, DeepEquals, basecrm.CustomFields{"Industry": "IT"})
+	c.Assert(contact.CreatedAt.Time, Equals, time.Date(2014, 8, 27, 16, 32, 56, 0, time.UTC))
+}
+
+func (s *FieldsSuite) TestSetField_Errors(c *C) {
+	v := reflect.ValueOf(new(basecrm.Deal)).Elem()
+
+	c.Assert(setField(v, "unknown", "1"), ErrorMatches, `unknown field "unknown"`)
+	c.Assert(setField(v, "name.first", "1"), ErrorMatches, `unknown field "name.first"`)
+	c.Assert(setField(v, "owner_id", "one"), ErrorMatches, `field "owner_id": "one" is not an integer`)
+	c.Assert(setField(v, "hot", "maybe"), ErrorMatches, `field "hot": "maybe" is not a boolean`)
+}
+
+func (s *FieldsSuite) TestGetField(c *C) {
+	lead := &basecrm.Lead{
+		Id:           1,
+		LastName:     "Johnson",
+		Tags:         []string{"vip", "important"},
+		Address:      &basecrm.Address{City: "Hyannis"},
+		CustomFields: basecrm.CustomFields{"Employees": 250.0, "Regions": []interface{}{"EU", "US"}},
+		CreatedAt:    basecrm.NewTimestamp(time.Date(2014, 8, 27, 16, 32, 56, 0, time.UTC)),
+	}
+	v := reflect.ValueOf(lead).Elem()
+
+	fields := map[string]string{
+		"id":                      "1",
+		"last_name":               "Johnson",
+		"first_name":              "",
+		"owner_id":                "",
+		"tags":                    "vip,important",
+		"address.city":            "Hyannis",
+		"custom_fields.Employees": "250",
+		"custom_fields.Regions":   "EU,US",
+		"custom_fields.Missing":   "",
+		"created_at":              "2014-08-27T16:32:56Z",
+		"updated_at":              "",
+	}
+	for field, expected := range fields {
+		value, err := getField(v, field)
+		c.Assert(err, IsNil)
+		c.Assert(value, Equals, expected, Commentf("field %s", field))
+	}
+
+	_, err := getField(v, "unknown")
+	c.Assert(err, ErrorMatches, `unknown field "unknown"`)
+}
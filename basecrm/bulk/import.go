@@ -0,0 +1,346 @@
+// Package bulk imports and exports contacts, leads and deals as CSV.
+//
+//	im := &bulk.Importer{
+//	  Client:   client,
+//	  Resource: basecrm.ContactResource,
+//	  Columns: []bulk.Column{
+//	    {Header: "First Name", Field: "first_name"},
+//	    {Header: "Last Name", Field: "last_name"},
+//	    {Header: "City", Field: "address.city"},
+//	    {Header: "Industry", Field: "custom_fields.Industry"},
+//	  },
+//	}
+//	results, err := im.Import(ctx, file)
+//	bulk.WriteReport(os.Stdout, results)
+package bulk
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/iaintshine/basecrm-go/basecrm"
+)
+
+const defaultConcurrency = 4
+
+// Status is the outcome of importing a row.
+type Status string
+
+const (
+	Created Status = "created"
+	Updated Status = "updated"
+	// Invalid rows failed validation and were not sent.
+	Invalid Status = "invalid"
+	// Failed rows were rejected by the API.
+	Failed Status = "failed"
+)
+
+// RowResult is the outcome of importing a single row.
+type RowResult struct {
+	// Line of the row in the CSV file, the header is on line 1.
+	Line   int
+	Status Status
+	// Id of the created or updated resource.
+	Id  int
+	Err error
+}
+
+// Importer creates or updates contacts, leads or deals from CSV rows.
+// Rows with a value in the id field update the existing resource, other rows create a new one.
+type Importer struct {
+	Client *basecrm.Client
+	// One of basecrm.ContactResource, basecrm.LeadResource or basecrm.DealResource.
+	Resource basecrm.ResourceType
+	// Columns to import. Other columns are ignored. When nil, the headers are used as field names.
+	Columns []Column
+	// Definitions of the resource's custom fields. When set, custom field values
+	// are converted to the types of their fields and validated.
+	CustomFields basecrm.CustomFieldDefinitions
+	// Number of rows sent concurrently. Defaults to 4.
+	Concurrency int
+}
+
+type row struct {
+	index  int
+	record interface{}
+}
+
+// Import reads the CSV from r and imports its rows. It returns one result per row,
+// in the order of the rows. An error is returned only when the CSV can not be read.
+func (im *Importer) Import(ctx context.Context, r io.Reader) ([]*RowResult, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("bulk: reading header: %w", err)
+	}
+
+	fields, err := im.fields(header)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*RowResult
+	var rows []*row
+	for {
+		values, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("bulk: %w", err)
+		}
+		line, _ := reader.FieldPos(0)
+
+		result := &RowResult{Line: line}
+		results = append(results, result)
+
+		record, err := im.decode(fields, values)
+		if err != nil {
+			result.Status = Invalid
+			result.Err = err
+			continue
+		}
+
+		rows = append(rows, &row{index: len(results) - 1, record: record})
+	}
+
+	im.save(ctx, rows, results)
+
+	return results, nil
+}
+
+// fields returns the field of every CSV column, or an empty string for ignored columns.
+func (im *Importer) fields(header []string) ([]string, error) {
+	if _, err := im.newRecord(); err != nil {
+		return nil, err
+	}
+
+	fields := make([]string, len(header))
+	if im.Columns == nil {
+		copy(fields, header)
+		return fields, nil
+	}
+
+	for _, column := range im.Columns {
+		found := false
+		for i, h := range header {
+			if strings.TrimSpace(h) == column.Header {
+				fields[i] = column.Field
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("bulk: missing column %q", column.Header)
+		}
+	}
+	return fields, nil
+}
+
+func (im *Importer) newRecord() (interface{}, error) {
+	switch im.Resource {
+	case basecrm.ContactResource:
+		return new(basecrm.Contact), nil
+	case basecrm.LeadResource:
+		return new(basecrm.Lead), nil
+	case basecrm.DealResource:
+		return new(basecrm.Deal), nil
+	}
+	return nil, fmt.Errorf("bulk: unsupported resource %q", im.Resource)
+}
+
+// decode maps the values of a row to a new record and validates it.
+func (im *Importer) decode(fields, values []string) (interface{}, error) {
+	record, err := im.newRecord()
+	if err != nil {
+		return nil, err
+	}
+
+	v := reflect.ValueOf(record).Elem()
+	for i, value := range values {
+		if i >= len(fields) || fields[i] == "" {
+			continue
+		}
+		if err := setField(v, fields[i], strings.TrimSpace(value)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := im.convertCustomFields(record); err != nil {
+		return nil, err
+	}
+
+	return record, validate(record)
+}
+
+// convertCustomFields converts the custom field values, read as strings,
+// to the types of their definitions.
+func (im *Importer) convertCustomFields(record interface{}) error {
+	if im.CustomFields == nil {
+		return nil
+	}
+
+	var fields basecrm.CustomFields
+	switch r := record.(type) {
+	case *basecrm.Contact:
+		fields = r.CustomFields
+	case *basecrm.Lead:
+		fields = r.CustomFields
+	case *basecrm.Deal:
+		fields = r.CustomFields
+	}
+
+	for name, value := range fields {
+		raw, ok := value.(string)
+		def := im.CustomFields.Find(name)
+		if !ok || def == nil {
+			continue
+		}
+
+		switch def.Type {
+		case basecrm.NumberField:
+			if n, err := strconv.ParseFloat(raw, 64); err == nil {
+				fields[name] = n
+			}
+		case basecrm.BoolField:
+			if b, err := strconv.ParseBool(raw); err == nil {
+				fields[name] = b
+			}
+		case basecrm.MultiSelectListField:
+			fields[name] = splitList(raw)
+		}
+	}
+
+	return im.CustomFields.Validate(fields)
+}
+
+func validate(record interface{}) error {
+	switch r := record.(type) {
+	case *basecrm.Contact:
+		if r.IsOrganization && r.Name == "" {
+			return errors.New("organization contacts require a name")
+		}
+		if !r.IsOrganization && r.Id == 0 && r.LastName == "" {
+			return errors.New("contacts require a last name")
+		}
+	case *basecrm.Lead:
+		if r.Id == 0 && r.LastName == "" && r.OrganizationName == "" {
+			return errors.New("leads require a last name or an organization name")
+		}
+	case *basecrm.Deal:
+		if r.Id == 0 && r.Name == "" {
+			return errors.New("deals require a name")
+		}
+	}
+	return nil
+}
+
+// save sends the rows using a pool of workers.
+func (im *Importer) save(ctx context.Context, rows []*row, results []*RowResult) {
+	concurrency := im.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultConcurrency
+	}
+
+	queue := make(chan *row)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for r := range queue {
+				result := results[r.index]
+				result.Id, result.Status, result.Err = im.send(ctx, r.record)
+			}
+		}()
+	}
+
+	for _, r := range rows {
+		queue <- r
+	}
+	close(queue)
+	wg.Wait()
+}
+
+func (im *Importer) send(ctx context.Context, record interface{}) (int, Status, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, Failed, err
+	}
+
+	var err error
+	var id int
+	status := Created
+
+	switch r := record.(type) {
+	case *basecrm.Contact:
+		var contact *basecrm.Contact
+		if r.Id != 0 {
+			status = Updated
+			id, r.Id = r.Id, 0
+			contact, _, err = im.Client.Contacts.EditContext(ctx, id, r)
+		} else {
+			contact, _, err = im.Client.Contacts.CreateContext(ctx, r)
+		}
+		if contact != nil {
+			id = contact.Id
+		}
+	case *basecrm.Lead:
+		var lead *basecrm.Lead
+		if r.Id != 0 {
+			status = Updated
+			id, r.Id = r.Id, 0
+			lead, _, err = im.Client.Leads.EditContext(ctx, id, r)
+		} else {
+			lead, _, err = im.Client.Leads.CreateContext(ctx, r)
+		}
+		if lead != nil {
+			id = lead.Id
+		}
+	case *basecrm.Deal:
+		var deal *basecrm.Deal
+		if r.Id != 0 {
+			status = Updated
+			id, r.Id = r.Id, 0
+			deal, _, err = im.Client.Deals.EditContext(ctx, id, r)
+		} else {
+			deal, _, err = im.Client.Deals.CreateContext(ctx, r)
+		}
+		if deal != nil {
+			id = deal.Id
+		}
+	}
+
+	if err != nil {
+		return 0, Failed, err
+	}
+	return id, status, nil
+}
+
+// WriteReport writes the results as CSV with the line, status, id and error of every row.
+func WriteReport(w io.Writer, results []*RowResult) error {
+	writer := csv.NewWriter(w)
+	writer.Write([]string{"line", "status", "id", "error"})
+
+	for _, result := range results {
+		id, message := "", ""
+		if result.Id != 0 {
+			id = strconv.Itoa(result.Id)
+		}
+		if result.Err != nil {
+			message = result.Err.Error()
+		}
+		writer.Write([]string{strconv.Itoa(result.Line), string(result.Status), id, message})
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
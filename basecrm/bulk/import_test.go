@@ -0,0 +1,171 @@
+package bulk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/iaintshine/basecrm-go/basecrm"
+	. "gopkg.in/check.v1"
+)
+
+var (
+	mux *http.ServeMux
+
+	client *basecrm.Client
+
+	server *httptest.Server
+)
+
+func setup() {
+	mux = http.NewServeMux()
+	server = httptest.NewServer(mux)
+
+	client = basecrm.NewClient(nil)
+	url, _ := url.Parse(server.URL)
+	client.BaseURL = url
+}
+
+func teardown() {
+	server.Close()
+}
+
+func TestImporter(t *testing.T) { TestingT(t) }
+
+type ImporterSuite struct {
+}
+
+var _ = Suite(&ImporterSuite{})
+
+func (s *ImporterSuite) TestImport_Contacts(c *C) {
+	setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	var created []map[string]interface{}
+	mux.HandleFunc("/v2/contacts", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "POST")
+
+		var body struct {
+			Data map[string]interface{} `json:"data"`
+		}
+		json.NewDecoder(req.Body).Decode(&body)
+
+		mu.Lock()
+		created = append(created, body.Data)
+		mu.Unlock()
+
+		if body.Data["last_name"] == "Rejected" {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"errors": [{"error": {"code": "invalid", "message": "email is invalid", "details": "email"}}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"data": {"id": 10}}`)
+	})
+	mux.HandleFunc("/v2/contacts/5", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "PUT")
+		fmt.Fprint(w, `{"data": {"id": 5}}`)
+	})
+
+	csv := `Id,First Name,Last Name,City,Employees,Regions,Ignored
+,Mark,Johnson,Hyannis,250,"EU, US",x
+5,Anne,,,,,x
+,Jane,,,,,x
+,John,Doe,,many,,x
+,Bob,Rejected,,,,x
+`
+
+	im := &Importer{
+		Client:   client,
+		Resource: basecrm.ContactResource,
+		Columns: []Column{
+			{Header: "Id", Field: "id"},
+			{Header: "First Name", Field: "first_name"},
+			{Header: "Last Name", Field: "last_name"},
+			{Header: "City", Field: "address.city"},
+			{Header: "Employees", Field: "custom_fields.Employees"},
+			{Header: "Regions", Field: "custom_fields.Regions"},
+		},
+		CustomFields: basecrm.CustomFieldDefinitions{
+			{Name: "Employees", Type: basecrm.NumberField},
+			{Name: "Regions", Type: basecrm.MultiSelectListField, Choices: []*basecrm.CustomFieldChoice{{Name: "EU"}, {Name: "US"}}},
+		},
+		Concurrency: 2,
+	}
+
+	results, err := im.Import(context.Background(), strings.NewReader(csv))
+	c.Assert(err, IsNil)
+	c.Assert(results, HasLen, 5)
+
+	c.Assert(results[0], DeepEquals, &RowResult{Line: 2, Status: Created, Id: 10})
+	c.Assert(results[1], DeepEquals, &RowResult{Line: 3, Status: Updated, Id: 5})
+	c.Assert(results[2].Status, Equals, Invalid)
+	c.Assert(results[2].Err, ErrorMatches, "contacts require a last name")
+	c.Assert(results[3].Status, Equals, Invalid)
+	c.Assert(results[3].Err, ErrorMatches, `.*custom field "Employees".*`)
+	c.Assert(results[4].Status, Equals, Failed)
+	c.Assert(basecrm.IsValidation(results[4].Err), Equals, true)
+
+	c.Assert(created, HasLen, 2)
+	for _, data := range created {
+		if data["last_name"] == "Johnson" {
+			c.Assert(data["address"], DeepEquals, map[string]interface{}{"city": "Hyannis"})
+			c.Assert(data["custom_fields"], DeepEquals, map[string]interface{}{
+				"Employees": 250.0,
+				"Regions":   []interface{}{"EU", "US"},
+			})
+		}
+	}
+
+	var report bytes.Buffer
+	c.Assert(WriteReport(&report, results), IsNil)
+	lines := strings.Split(strings.TrimSpace(report.String()), "\n")
+	c.Assert(lines, HasLen, 6)
+	c.Assert(lines[0], Equals, "line,status,id,error")
+	c.Assert(lines[1], Equals, "2,created,10,")
+	c.Assert(lines[3], Equals, "4,invalid,,contacts require a last name")
+}
+
+func (s *ImporterSuite) TestImport_HeadersAsFields(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/deals", func(w http.ResponseWriter, req *http.Request) {
+		var body struct {
+			Data *basecrm.Deal `json:"data"`
+		}
+		json.NewDecoder(req.Body).Decode(&body)
+		c.Assert(body.Data.Name, Equals, "Website Redesign")
+		c.Assert(body.Data.Hot, Equals, true)
+		c.Assert(body.Data.Tags, DeepEquals, []string{"web"})
+		fmt.Fprint(w, `{"data": {"id": 1}}`)
+	})
+
+	im := &Importer{Client: client, Resource: basecrm.DealResource}
+	results, err := im.Import(context.Background(), strings.NewReader("name,hot,tags\nWebsite Redesign,true,web\n"))
+	c.Assert(err, IsNil)
+	c.Assert(results, DeepEquals, []*RowResult{{Line: 2, Status: Created, Id: 1}})
+}
+
+func (s *ImporterSuite) TestImport_Errors(c *C) {
+	im := &Importer{Resource: basecrm.DealResource, Columns: []Column{{Header: "Name", Field: "name"}}}
+	_, err := im.Import(context.Background(), strings.NewReader("Title\nWebsite\n"))
+	c.Assert(err, ErrorMatches, `bulk: missing column "Name"`)
+
+	im = &Importer{Resource: basecrm.ResourceType("task")}
+	_, err = im.Import(context.Background(), strings.NewReader("content\nCall\n"))
+	c.Assert(err, ErrorMatches, `bulk: unsupported resource "task"`)
+
+	im = &Importer{Resource: basecrm.LeadResource}
+	results, err := im.Import(context.Background(), strings.NewReader("last_name,unknown\nJohnson,1\n"))
+	c.Assert(err, IsNil)
+	c.Assert(results[0].Status, Equals, Invalid)
+	c.Assert(results[0].Err, ErrorMatches, `unknown field "unknown"`)
+}
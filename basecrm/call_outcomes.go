@@ -0,0 +1,123 @@
+package basecrm
+
+import (
+	"context"
+	"fmt"
+)
+
+type CallOutcome struct {
+	Id        int       `json:"id,omitempty"`
+	CreatorId int       `json:"creator_id,omitempty"`
+	Name      string    `json:"name,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+}
+
+// MarshalJSON encodes the CallOutcome, omitting its unset timestamps.
+func (c CallOutcome) MarshalJSON() ([]byte, error) {
+	type callOutcome CallOutcome
+	return marshalFields(callOutcome(c), nil, nil)
+}
+
+type CallOutcomeListOptions struct {
+	ListOptions
+}
+
+type CallOutcomesService interface {
+	List(opt *CallOutcomeListOptions) ([]*CallOutcome, *Response, error)
+	ListContext(ctx context.Context, opt *CallOutcomeListOptions) ([]*CallOutcome, *Response, error)
+	ListAll(opt *CallOutcomeListOptions) *Iter[*CallOutcome]
+	ListAllContext(ctx context.Context, opt *CallOutcomeListOptions) *Iter[*CallOutcome]
+	Get(id int) (*CallOutcome, *Response, error)
+	GetContext(ctx context.Context, id int) (*CallOutcome, *Response, error)
+}
+
+func NewCallOutcomesService(client *Client) CallOutcomesService {
+	return &CallOutcomesServiceOp{client}
+}
+
+type callOutcomeRoot struct {
+	CallOutcome *CallOutcome `json:"data"`
+	Meta        *struct {
+		Type string `json:"type,omitempty"`
+	} `json:"meta,omitempty"`
+}
+
+type callOutcomesRoot struct {
+	Items []*callOutcomeRoot `json:"items"`
+	Meta  *Meta              `json:"meta"`
+}
+
+func (r *callOutcomesRoot) CallOutcomes() []*CallOutcome {
+	callOutcomes := make([]*CallOutcome, len(r.Items))
+	for i, root := range r.Items {
+		callOutcomes[i] = root.CallOutcome
+	}
+	return callOutcomes
+}
+
+type CallOutcomesServiceOp struct {
+	client *Client
+}
+
+func (s *CallOutcomesServiceOp) List(opt *CallOutcomeListOptions) ([]*CallOutcome, *Response, error) {
+	return s.ListContext(context.Background(), opt)
+}
+
+func (s *CallOutcomesServiceOp) ListContext(ctx context.Context, opt *CallOutcomeListOptions) ([]*CallOutcome, *Response, error) {
+	u, err := addOptions("/v2/call_outcomes", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(callOutcomesRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	res.setMeta(root.Meta)
+
+	return root.CallOutcomes(), res, err
+}
+
+func (s *CallOutcomesServiceOp) ListAll(opt *CallOutcomeListOptions) *Iter[*CallOutcome] {
+	return s.ListAllContext(context.Background(), opt)
+}
+
+func (s *CallOutcomesServiceOp) ListAllContext(ctx context.Context, opt *CallOutcomeListOptions) *Iter[*CallOutcome] {
+	o := CallOutcomeListOptions{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIter(ctx, o.Page, func(ctx context.Context, page int) ([]*CallOutcome, *Response, error) {
+		o.Page = page
+		return s.ListContext(ctx, &o)
+	})
+}
+
+func (s *CallOutcomesServiceOp) Get(id int) (*CallOutcome, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+func (s *CallOutcomesServiceOp) GetContext(ctx context.Context, id int) (*CallOutcome, *Response, error) {
+	u := fmt.Sprintf("/v2/call_outcomes/%d", id)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(callOutcomeRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return root.CallOutcome, res, err
+}
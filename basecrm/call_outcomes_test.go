@@ -0,0 +1,113 @@
+package basecrm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+func TestCallOutcomesService(t *testing.T) { TestingT(t) }
+
+type CallOutcomesSuite struct {
+}
+
+var _ = Suite(&CallOutcomesSuite{})
+
+func (s *CallOutcomesSuite) TestCallOutcomesService_List_All(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/call_outcomes", func(w http.ResponseWriter, req *http.Request) {
+		expected := map[string]string{
+			"page":     "1",
+			"per_page": "25",
+			"ids":      "1,2,3",
+			"sort_by":  "name:desc,created_at:asc",
+		}
+		c.Assert(req, HasHttpMethod, "GET")
+		c.Assert(req, HasHttpHeader, "Accept", "application/json")
+		c.Assert(req, HasQueryParams, expected)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+     {
+      "items": [{
+          "data": {
+            "id": 1
+          },
+          "meta": {
+            "type": "call_outcome"
+          }
+      }, {
+          "data": {
+            "id": 2
+          },
+          "meta": {
+            "type": "call_outcome"
+          }
+      }],
+      "meta": {
+        "type": "collection",
+        "count": 2,
+        "links": {
+          "self": "http://api.getbase.com/v2/call_outcomes.json"
+        }
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	opt := &CallOutcomeListOptions{
+		ListOptions: ListOptions{
+			Page:    1,
+			PerPage: 25,
+			Ids:     []int{1, 2, 3},
+			SortBy:  []string{"name:desc", "created_at:asc"},
+		},
+	}
+	callOutcomes, res, err := client.CallOutcomes.List(opt)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(callOutcomes, NotNil)
+
+	c.Assert(len(callOutcomes), Equals, 2)
+	c.Assert(callOutcomes[0].Id, Equals, 1)
+	c.Assert(callOutcomes[1].Id, Equals, 2)
+}
+
+func (s *CallOutcomesSuite) TestCallOutcomesService_Get(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/call_outcomes/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "creator_id": 1,
+        "name": "Interested"
+      },
+      "meta": {
+        "type": "call_outcome"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	callOutcome, res, err := client.CallOutcomes.Get(1)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(callOutcome, NotNil)
+
+	c.Assert(callOutcome.Id, Equals, 1)
+	c.Assert(callOutcome.Name, Equals, "Interested")
+}
@@ -0,0 +1,206 @@
+package basecrm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type Call struct {
+	Id                int          `json:"id,omitempty"`
+	UserId            int          `json:"user_id,omitempty"`
+	ResourceType      ResourceType `json:"resource_type,omitempty"`
+	ResourceId        int          `json:"resource_id,omitempty"`
+	AssociatedDealIds []int        `json:"associated_deal_ids,omitempty"`
+	OutcomeId         int          `json:"outcome_id,omitempty"`
+	Summary           string       `json:"summary,omitempty"`
+	PhoneNumber       string       `json:"phone_number,omitempty"`
+	Duration          int          `json:"duration,omitempty"`
+	RecordingUrl      string       `json:"recording_url,omitempty"`
+	Incoming          bool         `json:"incoming,omitempty"`
+	Missed            bool         `json:"missed,omitempty"`
+	MadeAt            Timestamp    `json:"made_at,omitempty"`
+	UpdatedAt         Timestamp    `json:"updated_at,omitempty"`
+
+	ForceSendFields []string `json:"-"`
+	NullFields      []string `json:"-"`
+}
+
+// MarshalJSON encodes the Call, sending the fields listed in ForceSendFields and NullFields.
+func (c Call) MarshalJSON() ([]byte, error) {
+	type call Call
+	return marshalFields(call(c), c.ForceSendFields, c.NullFields)
+}
+
+type CallListOptions struct {
+	ResourceType ResourceType `url:"resource_type,omitempty"`
+	ResourceId   int          `url:"resource_id,omitempty"`
+
+	ListOptions
+}
+
+type CallsService interface {
+	List(opt *CallListOptions) ([]*Call, *Response, error)
+	ListContext(ctx context.Context, opt *CallListOptions) ([]*Call, *Response, error)
+	ListAll(opt *CallListOptions) *Iter[*Call]
+	ListAllContext(ctx context.Context, opt *CallListOptions) *Iter[*Call]
+	Get(id int) (*Call, *Response, error)
+	GetContext(ctx context.Context, id int) (*Call, *Response, error)
+	Create(call *Call) (*Call, *Response, error)
+	CreateContext(ctx context.Context, call *Call) (*Call, *Response, error)
+	Edit(id int, call *Call) (*Call, *Response, error)
+	EditContext(ctx context.Context, id int, call *Call) (*Call, *Response, error)
+	Delete(id int) (bool, *Response, error)
+	DeleteContext(ctx context.Context, id int) (bool, *Response, error)
+}
+
+func NewCallsService(client *Client) CallsService {
+	return &CallsServiceOp{client}
+}
+
+type callRoot struct {
+	Call *Call `json:"data"`
+	Meta *struct {
+		Type string `json:"type,omitempty"`
+	} `json:"meta,omitempty"`
+}
+
+type callsRoot struct {
+	Items []*callRoot `json:"items"`
+	Meta  *Meta       `json:"meta"`
+}
+
+func (r *callsRoot) Calls() []*Call {
+	calls := make([]*Call, len(r.Items))
+	for i, root := range r.Items {
+		calls[i] = root.Call
+	}
+	return calls
+}
+
+type CallsServiceOp struct {
+	client *Client
+}
+
+func (s *CallsServiceOp) List(opt *CallListOptions) ([]*Call, *Response, error) {
+	return s.ListContext(context.Background(), opt)
+}
+
+func (s *CallsServiceOp) ListContext(ctx context.Context, opt *CallListOptions) ([]*Call, *Response, error) {
+	u, err := addOptions("/v2/calls", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(callsRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	res.setMeta(root.Meta)
+
+	return root.Calls(), res, err
+}
+
+func (s *CallsServiceOp) ListAll(opt *CallListOptions) *Iter[*Call] {
+	return s.ListAllContext(context.Background(), opt)
+}
+
+func (s *CallsServiceOp) ListAllContext(ctx context.Context, opt *CallListOptions) *Iter[*Call] {
+	o := CallListOptions{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIter(ctx, o.Page, func(ctx context.Context, page int) ([]*Call, *Response, error) {
+		o.Page = page
+		return s.ListContext(ctx, &o)
+	})
+}
+
+func (s *CallsServiceOp) Get(id int) (*Call, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+func (s *CallsServiceOp) GetContext(ctx context.Context, id int) (*Call, *Response, error) {
+	u := fmt.Sprintf("/v2/calls/%d", id)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(callRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return root.Call, res, err
+}
+
+func (s *CallsServiceOp) Create(call *Call) (*Call, *Response, error) {
+	return s.CreateContext(context.Background(), call)
+}
+
+func (s *CallsServiceOp) CreateContext(ctx context.Context, call *Call) (*Call, *Response, error) {
+	u := "/v2/calls"
+	envelope := &callRoot{Call: call}
+	req, err := s.client.NewRequestContext(ctx, "POST", u, envelope)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(callRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return root.Call, res, err
+}
+
+func (s *CallsServiceOp) Edit(id int, call *Call) (*Call, *Response, error) {
+	return s.EditContext(context.Background(), id, call)
+}
+
+func (s *CallsServiceOp) EditContext(ctx context.Context, id int, call *Call) (*Call, *Response, error) {
+	u := fmt.Sprintf("/v2/calls/%d", id)
+	envelope := &callRoot{Call: call}
+	req, err := s.client.NewRequestContext(ctx, "PUT", u, envelope)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(callRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return root.Call, res, err
+}
+
+func (s *CallsServiceOp) Delete(id int) (bool, *Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+func (s *CallsServiceOp) DeleteContext(ctx context.Context, id int) (bool, *Response, error) {
+	u := fmt.Sprintf("/v2/calls/%d", id)
+	req, err := s.client.NewRequestContext(ctx, "DELETE", u, nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	res, err := s.client.Do(req, nil)
+	if err != nil {
+		return false, res, err
+	}
+
+	return res.StatusCode == http.StatusNoContent, res, err
+}
@@ -0,0 +1,244 @@
+package basecrm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+func TestCallsService(t *testing.T) { TestingT(t) }
+
+type CallsSuite struct {
+}
+
+var _ = Suite(&CallsSuite{})
+
+func (s *CallsSuite) TestCallsService_List_All(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/calls", func(w http.ResponseWriter, req *http.Request) {
+		expected := map[string]string{
+			"resource_type": "lead",
+			"resource_id":   "1",
+			"page":          "1",
+			"per_page":      "25",
+			"ids":           "1,2,3",
+			"sort_by":       "name:desc,created_at:asc",
+		}
+		c.Assert(req, HasHttpMethod, "GET")
+		c.Assert(req, HasHttpHeader, "Accept", "application/json")
+		c.Assert(req, HasQueryParams, expected)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+     {
+      "items": [{
+          "data": {
+            "id": 1
+          },
+          "meta": {
+            "type": "call"
+          }
+      }, {
+          "data": {
+            "id": 2
+          },
+          "meta": {
+            "type": "call"
+          }
+      }],
+      "meta": {
+        "type": "collection",
+        "count": 2,
+        "links": {
+          "self": "http://api.getbase.com/v2/calls.json"
+        }
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	opt := &CallListOptions{
+		ResourceType: LeadResource,
+		ResourceId:   1,
+		ListOptions: ListOptions{
+			Page:    1,
+			PerPage: 25,
+			Ids:     []int{1, 2, 3},
+			SortBy:  []string{"name:desc", "created_at:asc"},
+		},
+	}
+	calls, res, err := client.Calls.List(opt)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(calls, NotNil)
+
+	c.Assert(len(calls), Equals, 2)
+	c.Assert(calls[0].Id, Equals, 1)
+	c.Assert(calls[1].Id, Equals, 2)
+}
+
+func (s *CallsSuite) TestCallsService_Get(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/calls/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "user_id": 1,
+        "resource_type": "contact",
+        "resource_id": 2,
+        "associated_deal_ids": [3],
+        "outcome_id": 4,
+        "duration": 120,
+        "phone_number": "+44-208-1234567",
+        "recording_url": "https://api.getbase.com/v2/calls/1/recording.mp3",
+        "incoming": true,
+        "made_at": "2014-09-28T16:32:56Z"
+      },
+      "meta": {
+        "type": "call"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	call, res, err := client.Calls.Get(1)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(call, NotNil)
+
+	c.Assert(call.Id, Equals, 1)
+	c.Assert(call.ResourceType, Equals, ContactResource)
+	c.Assert(call.AssociatedDealIds, DeepEquals, []int{3})
+	c.Assert(call.OutcomeId, Equals, 4)
+	c.Assert(call.Duration, Equals, 120)
+	c.Assert(call.RecordingUrl, Equals, "https://api.getbase.com/v2/calls/1/recording.mp3")
+	c.Assert(call.Incoming, Equals, true)
+}
+
+func (s *CallsSuite) TestCallsService_Create(c *C) {
+	setup()
+	defer teardown()
+
+	input := &Call{
+		ResourceType: LeadResource,
+		ResourceId:   1,
+		Duration:     60,
+		PhoneNumber:  "+44-208-1234567",
+	}
+
+	expected := &Call{
+		Id:           1,
+		ResourceType: LeadResource,
+		ResourceId:   1,
+		Duration:     60,
+		PhoneNumber:  "+44-208-1234567",
+	}
+
+	mux.HandleFunc("/v2/calls", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "POST")
+
+		root := new(callRoot)
+		json.NewDecoder(req.Body).Decode(root)
+		c.Assert(root.Call, NotNil)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "resource_type": "lead",
+        "resource_id": 1,
+        "duration": 60,
+        "phone_number": "+44-208-1234567"
+      },
+      "meta": {
+        "type": "call"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	call, res, err := client.Calls.Create(input)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(call, NotNil)
+
+	c.Assert(call, DeepEquals, expected)
+}
+
+func (s *CallsSuite) TestCallsService_Edit(c *C) {
+	setup()
+	defer teardown()
+
+	input := &Call{
+		Summary: "Left a voicemail",
+	}
+
+	expected := &Call{
+		Id:      1,
+		Summary: "Left a voicemail",
+	}
+
+	mux.HandleFunc("/v2/calls/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "PUT")
+
+		root := new(callRoot)
+		json.NewDecoder(req.Body).Decode(root)
+		c.Assert(root.Call, NotNil)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "summary": "Left a voicemail"
+      },
+      "meta": {
+        "type": "call"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	call, res, err := client.Calls.Edit(1, input)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(call, NotNil)
+
+	c.Assert(call, DeepEquals, expected)
+}
+
+func (s *CallsSuite) TestCallsService_Delete(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/calls/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "DELETE")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	deleted, res, err := client.Calls.Delete(1)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(deleted, Equals, true)
+}
@@ -0,0 +1,177 @@
+package basecrm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Collaboration grants a user, the collaborator, access to a deal, lead or contact
+// owned by someone else.
+type Collaboration struct {
+	Id             int          `json:"id,omitempty"`
+	CreatorId      int          `json:"creator_id,omitempty"`
+	CollaboratorId int          `json:"collaborator_id,omitempty"`
+	ResourceType   ResourceType `json:"resource_type,omitempty"`
+	ResourceId     int          `json:"resource_id,omitempty"`
+	UpdatedAt      Timestamp    `json:"updated_at,omitempty"`
+	CreatedAt      Timestamp    `json:"created_at,omitempty"`
+}
+
+// MarshalJSON encodes the Collaboration, omitting its unset timestamps.
+func (c Collaboration) MarshalJSON() ([]byte, error) {
+	type collaboration Collaboration
+	return marshalFields(collaboration(c), nil, nil)
+}
+
+type CollaborationListOptions struct {
+	CollaboratorId int `url:"collaborator_id,omitempty"`
+
+	ResourceType ResourceType `url:"resource_type,omitempty"`
+	ResourceId   int          `url:"resource_id,omitempty"`
+
+	ListOptions
+}
+
+type CollaborationsService interface {
+	List(opt *CollaborationListOptions) ([]*Collaboration, *Response, error)
+	ListContext(ctx context.Context, opt *CollaborationListOptions) ([]*Collaboration, *Response, error)
+	ListAll(opt *CollaborationListOptions) *Iter[*Collaboration]
+	ListAllContext(ctx context.Context, opt *CollaborationListOptions) *Iter[*Collaboration]
+	Get(id int) (*Collaboration, *Response, error)
+	GetContext(ctx context.Context, id int) (*Collaboration, *Response, error)
+	Create(collaboration *Collaboration) (*Collaboration, *Response, error)
+	CreateContext(ctx context.Context, collaboration *Collaboration) (*Collaboration, *Response, error)
+	Delete(id int) (bool, *Response, error)
+	DeleteContext(ctx context.Context, id int) (bool, *Response, error)
+}
+
+func NewCollaborationsService(client *Client) CollaborationsService {
+	return &CollaborationsServiceOp{client}
+}
+
+type collaborationRoot struct {
+	Collaboration *Collaboration `json:"data"`
+	Meta          *struct {
+		Type string `json:"type,omitempty"`
+	} `json:"meta,omitempty"`
+}
+
+type collaborationsRoot struct {
+	Items []*collaborationRoot `json:"items"`
+	Meta  *Meta                `json:"meta"`
+}
+
+func (r *collaborationsRoot) Collaborations() []*Collaboration {
+	collaborations := make([]*Collaboration, len(r.Items))
+	for i, root := range r.Items {
+		collaborations[i] = root.Collaboration
+	}
+	return collaborations
+}
+
+type CollaborationsServiceOp struct {
+	client *Client
+}
+
+func (s *CollaborationsServiceOp) List(opt *CollaborationListOptions) ([]*Collaboration, *Response, error) {
+	return s.ListContext(context.Background(), opt)
+}
+
+func (s *CollaborationsServiceOp) ListContext(ctx context.Context, opt *CollaborationListOptions) ([]*Collaboration, *Response, error) {
+	u, err := addOptions("/v2/collaborations", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(collaborationsRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	res.setMeta(root.Meta)
+
+	return root.Collaborations(), res, err
+}
+
+func (s *CollaborationsServiceOp) ListAll(opt *CollaborationListOptions) *Iter[*Collaboration] {
+	return s.ListAllContext(context.Background(), opt)
+}
+
+func (s *CollaborationsServiceOp) ListAllContext(ctx context.Context, opt *CollaborationListOptions) *Iter[*Collaboration] {
+	o := CollaborationListOptions{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIter(ctx, o.Page, func(ctx context.Context, page int) ([]*Collaboration, *Response, error) {
+		o.Page = page
+		return s.ListContext(ctx, &o)
+	})
+}
+
+func (s *CollaborationsServiceOp) Get(id int) (*Collaboration, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+func (s *CollaborationsServiceOp) GetContext(ctx context.Context, id int) (*Collaboration, *Response, error) {
+	u := fmt.Sprintf("/v2/collaborations/%d", id)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(collaborationRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return root.Collaboration, res, err
+}
+
+func (s *CollaborationsServiceOp) Create(collaboration *Collaboration) (*Collaboration, *Response, error) {
+	return s.CreateContext(context.Background(), collaboration)
+}
+
+func (s *CollaborationsServiceOp) CreateContext(ctx context.Context, collaboration *Collaboration) (*Collaboration, *Response, error) {
+	u := "/v2/collaborations"
+	envelope := &collaborationRoot{Collaboration: collaboration}
+	req, err := s.client.NewRequestContext(ctx, "POST", u, envelope)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(collaborationRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return root.Collaboration, res, err
+}
+
+func (s *CollaborationsServiceOp) Delete(id int) (bool, *Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+func (s *CollaborationsServiceOp) DeleteContext(ctx context.Context, id int) (bool, *Response, error) {
+	u := fmt.Sprintf("/v2/collaborations/%d", id)
+	req, err := s.client.NewRequestContext(ctx, "DELETE", u, nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	res, err := s.client.Do(req, nil)
+	if err != nil {
+		return false, res, err
+	}
+
+	return res.StatusCode == http.StatusNoContent, res, err
+}
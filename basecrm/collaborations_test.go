@@ -0,0 +1,190 @@
+package basecrm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+func TestCollaborationsService(t *testing.T) { TestingT(t) }
+
+type CollaborationsSuite struct {
+}
+
+var _ = Suite(&CollaborationsSuite{})
+
+func (s *CollaborationsSuite) TestCollaborationsService_List_All(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/collaborations", func(w http.ResponseWriter, req *http.Request) {
+		expected := map[string]string{
+			"collaborator_id": "2",
+			"resource_type":   "deal",
+			"resource_id":     "1",
+			"page":            "1",
+			"per_page":        "25",
+			"ids":             "1,2,3",
+			"sort_by":         "name:desc,created_at:asc",
+		}
+		c.Assert(req, HasHttpMethod, "GET")
+		c.Assert(req, HasHttpHeader, "Accept", "application/json")
+		c.Assert(req, HasQueryParams, expected)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+     {
+      "items": [{
+          "data": {
+            "id": 1
+          },
+          "meta": {
+            "type": "collaboration"
+          }
+      }, {
+          "data": {
+            "id": 2
+          },
+          "meta": {
+            "type": "collaboration"
+          }
+      }],
+      "meta": {
+        "type": "collection",
+        "count": 2,
+        "links": {
+          "self": "http://api.getbase.com/v2/collaborations.json"
+        }
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	opt := &CollaborationListOptions{
+		CollaboratorId: 2,
+		ResourceType:   DealResource,
+		ResourceId:     1,
+		ListOptions: ListOptions{
+			Page:    1,
+			PerPage: 25,
+			Ids:     []int{1, 2, 3},
+			SortBy:  []string{"name:desc", "created_at:asc"},
+		},
+	}
+	collaborations, res, err := client.Collaborations.List(opt)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(collaborations, NotNil)
+
+	c.Assert(len(collaborations), Equals, 2)
+	c.Assert(collaborations[0].Id, Equals, 1)
+	c.Assert(collaborations[1].Id, Equals, 2)
+}
+
+func (s *CollaborationsSuite) TestCollaborationsService_Get(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/collaborations/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "creator_id": 1,
+        "collaborator_id": 2,
+        "resource_type": "lead",
+        "resource_id": 3
+      },
+      "meta": {
+        "type": "collaboration"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	collaboration, res, err := client.Collaborations.Get(1)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(collaboration, NotNil)
+
+	c.Assert(collaboration.Id, Equals, 1)
+	c.Assert(collaboration.CollaboratorId, Equals, 2)
+	c.Assert(collaboration.ResourceType, Equals, LeadResource)
+	c.Assert(collaboration.ResourceId, Equals, 3)
+}
+
+func (s *CollaborationsSuite) TestCollaborationsService_Create(c *C) {
+	setup()
+	defer teardown()
+
+	input := &Collaboration{
+		CollaboratorId: 2,
+		ResourceType:   ContactResource,
+		ResourceId:     3,
+	}
+
+	expected := &Collaboration{
+		Id:             1,
+		CollaboratorId: 2,
+		ResourceType:   ContactResource,
+		ResourceId:     3,
+	}
+
+	mux.HandleFunc("/v2/collaborations", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "POST")
+
+		root := new(collaborationRoot)
+		json.NewDecoder(req.Body).Decode(root)
+		c.Assert(root.Collaboration, NotNil)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "collaborator_id": 2,
+        "resource_type": "contact",
+        "resource_id": 3
+      },
+      "meta": {
+        "type": "collaboration"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	collaboration, res, err := client.Collaborations.Create(input)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(collaboration, NotNil)
+
+	c.Assert(collaboration, DeepEquals, expected)
+}
+
+func (s *CollaborationsSuite) TestCollaborationsService_Delete(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/collaborations/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "DELETE")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	deleted, res, err := client.Collaborations.Delete(1)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(deleted, Equals, true)
+}
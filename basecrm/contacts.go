@@ -1,39 +1,48 @@
 package basecrm
 
 import (
+	"context"
 	"fmt"
 	"net/http"
-	"time"
 )
 
 type Contact struct {
-	Id             int                    `json:"id,omitempty"`
-	CreatorId      int                    `json:"creator_id,omitempty"`
-	OwnerId        int                    `json:"owner_id,omitempty"`
-	IsOrganization bool                   `json:"is_organization,omitempty"`
-	Private        bool                   `json:"private"`
-	Name           string                 `json:"name,omitempty"`
-	FirstName      string                 `json:"first_name,omitempty"`
-	LastName       string                 `json:"last_name,omitempty"`
-	CustomerStatus string                 `json:"customer_status,omitempty"`
-	ProspectStatus string                 `json:"prospect_status,omitempty"`
-	Title          string                 `json:"title,omitempty"`
-	Description    string                 `json:"description,omitempty"`
-	Industry       string                 `json:"industry,omitempty"`
-	Website        string                 `json:"website,omitempty"`
-	Email          string                 `json:"email,omitempty"`
-	Phone          string                 `json:"phone,omitempty"`
-	Mobile         string                 `json:"mobile,omitempty"`
-	Fax            string                 `json:"fax,omitempty"`
-	Twitter        string                 `json:"twitter,omitempty"`
-	Facebook       string                 `json:"facebook,omitempty"`
-	Linkedin       string                 `json:"linkedin,omitempty"`
-	Skype          string                 `json:"skype,omitempty"`
-	Address        *Address               `json:"address,omitempty"`
-	Tags           []string               `json:"tags,omitempty"`
-	CustomFields   map[string]interface{} `json:"custom_fields,omitempty"`
-	UpdatedAt      time.Time              `json:"updated_at,omitempty"`
-	CreatedAt      time.Time              `json:"created_at,omitempty"`
+	Id             int          `json:"id,omitempty"`
+	CreatorId      int          `json:"creator_id,omitempty"`
+	OwnerId        int          `json:"owner_id,omitempty"`
+	IsOrganization bool         `json:"is_organization,omitempty"`
+	Private        bool         `json:"private,omitempty"`
+	Name           string       `json:"name,omitempty"`
+	FirstName      string       `json:"first_name,omitempty"`
+	LastName       string       `json:"last_name,omitempty"`
+	CustomerStatus string       `json:"customer_status,omitempty"`
+	ProspectStatus string       `json:"prospect_status,omitempty"`
+	Title          string       `json:"title,omitempty"`
+	Description    string       `json:"description,omitempty"`
+	Industry       string       `json:"industry,omitempty"`
+	Website        string       `json:"website,omitempty"`
+	Email          string       `json:"email,omitempty"`
+	Phone          string       `json:"phone,omitempty"`
+	Mobile         string       `json:"mobile,omitempty"`
+	Fax            string       `json:"fax,omitempty"`
+	Twitter        string       `json:"twitter,omitempty"`
+	Facebook       string       `json:"facebook,omitempty"`
+	Linkedin       string       `json:"linkedin,omitempty"`
+	Skype          string       `json:"skype,omitempty"`
+	Address        *Address     `json:"address,omitempty"`
+	Tags           []string     `json:"tags,omitempty"`
+	CustomFields   CustomFields `json:"custom_fields,omitempty"`
+	UpdatedAt      Timestamp    `json:"updated_at,omitempty"`
+	CreatedAt      Timestamp    `json:"created_at,omitempty"`
+
+	ForceSendFields []string `json:"-"`
+	NullFields      []string `json:"-"`
+}
+
+// MarshalJSON encodes the Contact, sending the fields listed in ForceSendFields and NullFields.
+func (c Contact) MarshalJSON() ([]byte, error) {
+	type contact Contact
+	return marshalFields(contact(c), c.ForceSendFields, c.NullFields)
 }
 
 type ContactListOptions struct {
@@ -61,10 +70,17 @@ type ContactListOptions struct {
 
 type ContactsService interface {
 	List(opt *ContactListOptions) ([]*Contact, *Response, error)
+	ListContext(ctx context.Context, opt *ContactListOptions) ([]*Contact, *Response, error)
+	ListAll(opt *ContactListOptions) *Iter[*Contact]
+	ListAllContext(ctx context.Context, opt *ContactListOptions) *Iter[*Contact]
 	Get(id int) (*Contact, *Response, error)
+	GetContext(ctx context.Context, id int) (*Contact, *Response, error)
 	Create(contact *Contact) (*Contact, *Response, error)
+	CreateContext(ctx context.Context, contact *Contact) (*Contact, *Response, error)
 	Edit(id int, contact *Contact) (*Contact, *Response, error)
+	EditContext(ctx context.Context, id int, contact *Contact) (*Contact, *Response, error)
 	Delete(id int) (bool, *Response, error)
+	DeleteContext(ctx context.Context, id int) (bool, *Response, error)
 }
 
 func NewContactsService(client *Client) ContactsService {
@@ -96,12 +112,16 @@ type ContactsServiceOp struct {
 }
 
 func (s *ContactsServiceOp) List(opt *ContactListOptions) ([]*Contact, *Response, error) {
+	return s.ListContext(context.Background(), opt)
+}
+
+func (s *ContactsServiceOp) ListContext(ctx context.Context, opt *ContactListOptions) ([]*Contact, *Response, error) {
 	u, err := addOptions("/v2/contacts", opt)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -112,12 +132,34 @@ func (s *ContactsServiceOp) List(opt *ContactListOptions) ([]*Contact, *Response
 		return nil, res, err
 	}
 
+	res.setMeta(root.Meta)
+
 	return root.Contacts(), res, err
 }
 
+func (s *ContactsServiceOp) ListAll(opt *ContactListOptions) *Iter[*Contact] {
+	return s.ListAllContext(context.Background(), opt)
+}
+
+func (s *ContactsServiceOp) ListAllContext(ctx context.Context, opt *ContactListOptions) *Iter[*Contact] {
+	o := ContactListOptions{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIter(ctx, o.Page, func(ctx context.Context, page int) ([]*Contact, *Response, error) {
+		o.Page = page
+		return s.ListContext(ctx, &o)
+	})
+}
+
 func (s *ContactsServiceOp) Get(id int) (*Contact, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+func (s *ContactsServiceOp) GetContext(ctx context.Context, id int) (*Contact, *Response, error) {
 	u := fmt.Sprintf("/v2/contacts/%d", id)
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -132,9 +174,13 @@ func (s *ContactsServiceOp) Get(id int) (*Contact, *Response, error) {
 }
 
 func (s *ContactsServiceOp) Create(contact *Contact) (*Contact, *Response, error) {
+	return s.CreateContext(context.Background(), contact)
+}
+
+func (s *ContactsServiceOp) CreateContext(ctx context.Context, contact *Contact) (*Contact, *Response, error) {
 	u := "/v2/contacts"
 	envelope := &contactRoot{Contact: contact}
-	req, err := s.client.NewRequest("POST", u, envelope)
+	req, err := s.client.NewRequestContext(ctx, "POST", u, envelope)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -149,9 +195,13 @@ func (s *ContactsServiceOp) Create(contact *Contact) (*Contact, *Response, error
 }
 
 func (s *ContactsServiceOp) Edit(id int, contact *Contact) (*Contact, *Response, error) {
+	return s.EditContext(context.Background(), id, contact)
+}
+
+func (s *ContactsServiceOp) EditContext(ctx context.Context, id int, contact *Contact) (*Contact, *Response, error) {
 	u := fmt.Sprintf("/v2/contacts/%d", id)
 	envelope := &contactRoot{Contact: contact}
-	req, err := s.client.NewRequest("PUT", u, envelope)
+	req, err := s.client.NewRequestContext(ctx, "PUT", u, envelope)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -166,8 +216,12 @@ func (s *ContactsServiceOp) Edit(id int, contact *Contact) (*Contact, *Response,
 }
 
 func (s *ContactsServiceOp) Delete(id int) (bool, *Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+func (s *ContactsServiceOp) DeleteContext(ctx context.Context, id int) (bool, *Response, error) {
 	u := fmt.Sprintf("/v2/contacts/%d", id)
-	req, err := s.client.NewRequest("DELETE", u, nil)
+	req, err := s.client.NewRequestContext(ctx, "DELETE", u, nil)
 	if err != nil {
 		return false, nil, err
 	}
@@ -0,0 +1,173 @@
+package basecrm
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// customFieldDateFormat is the format of date custom field values.
+const customFieldDateFormat = "2006-01-02"
+
+// CustomFields holds the custom field values of a Lead, Contact or Deal, keyed by field name.
+// Values decoded from the API keep their JSON representation; use the typed getters
+// to read them. The setters do not check the values: use CustomFieldDefinitions.Set to
+// set a value checked against its definition, or CustomFieldDefinitions.Validate to
+// check all values before sending.
+type CustomFields map[string]interface{}
+
+// String returns the value of a string, text, email, phone, url or list field.
+func (f CustomFields) String(name string) (string, bool) {
+	v, ok := f[name].(string)
+	return v, ok
+}
+
+// Number returns the value of a number field. The API may send numbers as JSON strings.
+func (f CustomFields) Number(name string) (float64, bool) {
+	return toNumber(f[name])
+}
+
+// Date returns the value of a date field.
+func (f CustomFields) Date(name string) (time.Time, bool) {
+	return toTime(f[name], customFieldDateFormat)
+}
+
+// DateTime returns the value of a datetime field.
+func (f CustomFields) DateTime(name string) (time.Time, bool) {
+	return toTime(f[name], time.RFC3339)
+}
+
+// Bool returns the value of a bool field.
+func (f CustomFields) Bool(name string) (bool, bool) {
+	v, ok := f[name].(bool)
+	return v, ok
+}
+
+// List returns the selected choices of a list or multi select list field.
+func (f CustomFields) List(name string) ([]string, bool) {
+	if v, ok := f[name].(string); ok {
+		return []string{v}, true
+	}
+	return toStrings(f[name])
+}
+
+// Address returns the value of an address field.
+func (f CustomFields) Address(name string) (*Address, bool) {
+	return toAddress(f[name])
+}
+
+// SetString sets the value of a string, text, email, phone, url or list field.
+func (f *CustomFields) SetString(name, value string) {
+	f.set(name, value)
+}
+
+// SetNumber sets the value of a number field.
+func (f *CustomFields) SetNumber(name string, value float64) {
+	f.set(name, value)
+}
+
+// SetDate sets the value of a date field. Only the date part of value is sent.
+func (f *CustomFields) SetDate(name string, value time.Time) {
+	f.set(name, value.Format(customFieldDateFormat))
+}
+
+// SetDateTime sets the value of a datetime field.
+func (f *CustomFields) SetDateTime(name string, value time.Time) {
+	f.set(name, value.Format(time.RFC3339))
+}
+
+// SetBool sets the value of a bool field.
+func (f *CustomFields) SetBool(name string, value bool) {
+	f.set(name, value)
+}
+
+// SetList sets the selected choices of a multi select list field.
+func (f *CustomFields) SetList(name string, values []string) {
+	f.set(name, values)
+}
+
+// SetAddress sets the value of an address field.
+func (f *CustomFields) SetAddress(name string, value *Address) {
+	f.set(name, value)
+}
+
+// Clear sets the field to null, which removes its value.
+func (f *CustomFields) Clear(name string) {
+	f.set(name, nil)
+}
+
+func (f *CustomFields) set(name string, value interface{}) {
+	if *f == nil {
+		*f = CustomFields{}
+	}
+	(*f)[name] = value
+}
+
+func toNumber(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	case string:
+		f, err := strconv.ParseFloat(n, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func toTime(v interface{}, layout string) (time.Time, bool) {
+	switch t := v.(type) {
+	case time.Time:
+		return t, true
+	case string:
+		parsed, err := time.Parse(layout, t)
+		return parsed, err == nil
+	}
+	return time.Time{}, false
+}
+
+func toStrings(v interface{}) ([]string, bool) {
+	switch l := v.(type) {
+	case []string:
+		return l, true
+	case []interface{}:
+		values := make([]string, len(l))
+		for i, item := range l {
+			s, ok := item.(string)
+			if !ok {
+				return nil, false
+			}
+			values[i] = s
+		}
+		return values, true
+	}
+	return nil, false
+}
+
+func toAddress(v interface{}) (*Address, bool) {
+	switch a := v.(type) {
+	case *Address:
+		return a, a != nil
+	case Address:
+		return &a, true
+	case map[string]interface{}:
+		data, err := json.Marshal(a)
+		if err != nil {
+			return nil, false
+		}
+		address := new(Address)
+		if err := json.Unmarshal(data, address); err != nil {
+			return nil, false
+		}
+		return address, true
+	}
+	return nil, false
+}
@@ -0,0 +1,93 @@
+package basecrm
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func TestCustomFieldValues(t *testing.T) { TestingT(t) }
+
+type CustomFieldValuesSuite struct {
+}
+
+var _ = Suite(&CustomFieldValuesSuite{})
+
+func (s *CustomFieldValuesSuite) TestCustomFields_Getters(c *C) {
+	jsonBlob := `
+  {
+    "id": 1,
+    "custom_fields": {
+      "Known via": "Tom",
+      "Employees": 120,
+      "Revenue": "1200.50",
+      "Birthday": "1980-05-01",
+      "Last contacted": "2014-09-28T16:32:56Z",
+      "VIP": true,
+      "Tier": "Gold",
+      "Interests": ["Golf", "Tennis"],
+      "Billing address": {"line1": "2726 Smith Street", "city": "Hyannis"}
+    }
+  }
+  `
+	contact := new(Contact)
+	err := json.Unmarshal([]byte(jsonBlob), contact)
+	c.Assert(err, IsNil)
+
+	fields := contact.CustomFields
+
+	knownVia, ok := fields.String("Known via")
+	c.Assert(ok, Equals, true)
+	c.Assert(knownVia, Equals, "Tom")
+
+	employees, ok := fields.Number("Employees")
+	c.Assert(ok, Equals, true)
+	c.Assert(employees, Equals, 120.0)
+
+	revenue, ok := fields.Number("Revenue")
+	c.Assert(ok, Equals, true)
+	c.Assert(revenue, Equals, 1200.5)
+
+	birthday, ok := fields.Date("Birthday")
+	c.Assert(ok, Equals, true)
+	c.Assert(birthday, Equals, time.Date(1980, time.May, 1, 0, 0, 0, 0, time.UTC))
+
+	lastContacted, ok := fields.DateTime("Last contacted")
+	c.Assert(ok, Equals, true)
+	c.Assert(lastContacted, Equals, time.Date(2014, time.September, 28, 16, 32, 56, 0, time.UTC))
+
+	vip, ok := fields.Bool("VIP")
+	c.Assert(ok, Equals, true)
+	c.Assert(vip, Equals, true)
+
+	tier, ok := fields.List("Tier")
+	c.Assert(ok, Equals, true)
+	c.Assert(tier, DeepEquals, []string{"Gold"})
+
+	interests, ok := fields.List("Interests")
+	c.Assert(ok, Equals, true)
+	c.Assert(interests, DeepEquals, []string{"Golf", "Tennis"})
+
+	address, ok := fields.Address("Billing address")
+	c.Assert(ok, Equals, true)
+	c.Assert(address, DeepEquals, &Address{Line1: "2726 Smith Street", City: "Hyannis"})
+
+	_, ok = fields.String("Employees")
+	c.Assert(ok, Equals, false)
+	_, ok = fields.Bool("Missing")
+	c.Assert(ok, Equals, false)
+}
+
+func (s *CustomFieldValuesSuite) TestCustomFields_Setters(c *C) {
+	deal := &Deal{Name: "Website redesign"}
+	deal.CustomFields.SetString("Known via", "Tom")
+	deal.CustomFields.SetDate("Closing date", time.Date(2014, time.November, 1, 12, 0, 0, 0, time.UTC))
+	deal.CustomFields.SetList("Interests", []string{"Golf"})
+	deal.CustomFields.Clear("Tier")
+
+	data, err := json.Marshal(deal)
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Matches, `.*"custom_fields":\{"Closing date":"2014-11-01","Interests":\["Golf"\],"Known via":"Tom","Tier":null\}.*`)
+}
@@ -0,0 +1,228 @@
+package basecrm
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type CustomFieldType string
+
+const (
+	StringField          CustomFieldType = "string"
+	TextField            CustomFieldType = "text"
+	NumberField          CustomFieldType = "number"
+	DateField            CustomFieldType = "date"
+	DateTimeField        CustomFieldType = "datetime"
+	BoolField            CustomFieldType = "bool"
+	ListField            CustomFieldType = "list"
+	MultiSelectListField CustomFieldType = "multi_select_list"
+	AddressField         CustomFieldType = "address"
+	EmailField           CustomFieldType = "email"
+	PhoneField           CustomFieldType = "phone"
+	UrlField             CustomFieldType = "url"
+)
+
+type CustomFieldChoice struct {
+	Id   int    `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+type CustomField struct {
+	Id         int                  `json:"id,omitempty"`
+	Name       string               `json:"name,omitempty"`
+	Type       CustomFieldType      `json:"type,omitempty"`
+	Choices    []*CustomFieldChoice `json:"choices,omitempty"`
+	ForCompany bool                 `json:"for_company,omitempty"`
+	ForContact bool                 `json:"for_contact,omitempty"`
+	UpdatedAt  Timestamp            `json:"updated_at,omitempty"`
+	CreatedAt  Timestamp            `json:"created_at,omitempty"`
+}
+
+// MarshalJSON encodes the CustomField, omitting its unset timestamps.
+func (c CustomField) MarshalJSON() ([]byte, error) {
+	type customField CustomField
+	return marshalFields(customField(c), nil, nil)
+}
+
+// HasChoice reports whether name is one of the field's choices.
+func (f *CustomField) HasChoice(name string) bool {
+	for _, choice := range f.Choices {
+		if choice.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// CustomFieldDefinitions is a set of custom field definitions of a single resource type,
+// as returned by CustomFieldsService.List.
+type CustomFieldDefinitions []*CustomField
+
+// Find returns the definition of the custom field with the given name, or nil.
+func (defs CustomFieldDefinitions) Find(name string) *CustomField {
+	for _, def := range defs {
+		if def.Name == name {
+			return def
+		}
+	}
+	return nil
+}
+
+// CustomFieldError reports a custom field value which does not match its definition.
+type CustomFieldError struct {
+	Name   string
+	Reason string
+}
+
+func (e *CustomFieldError) Error() string {
+	return fmt.Sprintf("basecrm: custom field %q: %s", e.Name, e.Reason)
+}
+
+// Validate checks that every value in fields is defined and matches the type
+// and choices of its definition. Nil values, which clear a field, are always valid.
+func (defs CustomFieldDefinitions) Validate(fields CustomFields) error {
+	for name, value := range fields {
+		if err := defs.ValidateValue(name, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Set checks value like ValidateValue and stores it in the custom field with the
+// given name of fields. Dates and datetimes given as time.Time are formatted like
+// CustomFields.SetDate and CustomFields.SetDateTime do.
+func (defs CustomFieldDefinitions) Set(fields *CustomFields, name string, value interface{}) error {
+	if err := defs.ValidateValue(name, value); err != nil {
+		return err
+	}
+
+	if t, ok := value.(time.Time); ok {
+		switch defs.Find(name).Type {
+		case DateField:
+			value = t.Format(customFieldDateFormat)
+		case DateTimeField:
+			value = t.Format(time.RFC3339)
+		}
+	}
+
+	fields.set(name, value)
+	return nil
+}
+
+// ValidateValue checks that value can be stored in the custom field with the given name.
+func (defs CustomFieldDefinitions) ValidateValue(name string, value interface{}) error {
+	def := defs.Find(name)
+	if def == nil {
+		return &CustomFieldError{Name: name, Reason: "field is not defined"}
+	}
+
+	if value == nil {
+		return nil
+	}
+
+	invalid := &CustomFieldError{Name: name, Reason: fmt.Sprintf("%T is not a valid %s value", value, def.Type)}
+
+	switch def.Type {
+	case StringField, TextField, EmailField, PhoneField, UrlField:
+		if _, ok := value.(string); !ok {
+			return invalid
+		}
+	case NumberField:
+		if _, ok := toNumber(value); !ok {
+			return invalid
+		}
+	case DateField:
+		if _, ok := toTime(value, customFieldDateFormat); !ok {
+			return invalid
+		}
+	case DateTimeField:
+		if _, ok := toTime(value, time.RFC3339); !ok {
+			return invalid
+		}
+	case BoolField:
+		if _, ok := value.(bool); !ok {
+			return invalid
+		}
+	case ListField:
+		choice, ok := value.(string)
+		if !ok {
+			return invalid
+		}
+		if !def.HasChoice(choice) {
+			return &CustomFieldError{Name: name, Reason: fmt.Sprintf("%q is not one of the choices", choice)}
+		}
+	case MultiSelectListField:
+		choices, ok := toStrings(value)
+		if !ok {
+			return invalid
+		}
+		for _, choice := range choices {
+			if !def.HasChoice(choice) {
+				return &CustomFieldError{Name: name, Reason: fmt.Sprintf("%q is not one of the choices", choice)}
+			}
+		}
+	case AddressField:
+		if _, ok := toAddress(value); !ok {
+			return invalid
+		}
+	}
+
+	return nil
+}
+
+type CustomFieldsService interface {
+	List(resourceType ResourceType) (CustomFieldDefinitions, *Response, error)
+	ListContext(ctx context.Context, resourceType ResourceType) (CustomFieldDefinitions, *Response, error)
+}
+
+func NewCustomFieldsService(client *Client) CustomFieldsService {
+	return &CustomFieldsServiceOp{client}
+}
+
+type customFieldRoot struct {
+	CustomField *CustomField `json:"data"`
+	Meta        *struct {
+		Type string `json:"type,omitempty"`
+	} `json:"meta,omitempty"`
+}
+
+type customFieldsRoot struct {
+	Items []*customFieldRoot `json:"items"`
+	Meta  *Meta              `json:"meta"`
+}
+
+func (r *customFieldsRoot) CustomFields() CustomFieldDefinitions {
+	customFields := make(CustomFieldDefinitions, len(r.Items))
+	for i, root := range r.Items {
+		customFields[i] = root.CustomField
+	}
+	return customFields
+}
+
+type CustomFieldsServiceOp struct {
+	client *Client
+}
+
+func (s *CustomFieldsServiceOp) List(resourceType ResourceType) (CustomFieldDefinitions, *Response, error) {
+	return s.ListContext(context.Background(), resourceType)
+}
+
+func (s *CustomFieldsServiceOp) ListContext(ctx context.Context, resourceType ResourceType) (CustomFieldDefinitions, *Response, error) {
+	u := fmt.Sprintf("/v2/%s/custom_fields", resourceType)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(customFieldsRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	res.setMeta(root.Meta)
+
+	return root.CustomFields(), res, err
+}
@@ -0,0 +1,139 @@
+package basecrm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func TestCustomFieldsService(t *testing.T) { TestingT(t) }
+
+type CustomFieldsSuite struct {
+}
+
+var _ = Suite(&CustomFieldsSuite{})
+
+func testCustomFieldDefinitions() CustomFieldDefinitions {
+	return CustomFieldDefinitions{
+		{Id: 1, Name: "Known via", Type: StringField},
+		{Id: 2, Name: "Employees", Type: NumberField},
+		{Id: 3, Name: "Birthday", Type: DateField},
+		{Id: 4, Name: "VIP", Type: BoolField},
+		{Id: 5, Name: "Tier", Type: ListField, Choices: []*CustomFieldChoice{{Id: 1, Name: "Gold"}, {Id: 2, Name: "Silver"}}},
+		{Id: 6, Name: "Interests", Type: MultiSelectListField, Choices: []*CustomFieldChoice{{Id: 1, Name: "Golf"}, {Id: 2, Name: "Tennis"}}},
+		{Id: 7, Name: "Billing address", Type: AddressField},
+	}
+}
+
+func (s *CustomFieldsSuite) TestCustomFieldsService_List(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/deal/custom_fields", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req, HasHttpMethod, "GET")
+		c.Assert(req, HasHttpHeader, "Accept", "application/json")
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "items": [{
+        "data": {
+          "id": 1,
+          "name": "Tier",
+          "type": "list",
+          "choices": [{"id": 1, "name": "Gold"}, {"id": 2, "name": "Silver"}],
+          "created_at": "2014-08-27T16:32:56Z",
+          "updated_at": "2014-08-27T16:32:56Z"
+        },
+        "meta": {
+          "type": "custom_field"
+        }
+      }, {
+        "data": {
+          "id": 2,
+          "name": "Closing date",
+          "type": "date"
+        },
+        "meta": {
+          "type": "custom_field"
+        }
+      }],
+      "meta": {
+        "type": "collection",
+        "count": 2
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	fields, res, err := client.CustomFields.List(DealResource)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(fields, HasLen, 2)
+
+	tier := fields.Find("Tier")
+	c.Assert(tier, NotNil)
+	c.Assert(tier.Type, Equals, ListField)
+	c.Assert(tier.HasChoice("Gold"), Equals, true)
+	c.Assert(tier.HasChoice("Bronze"), Equals, false)
+	c.Assert(fields.Find("Closing date").Type, Equals, DateField)
+	c.Assert(fields.Find("Unknown"), IsNil)
+}
+
+func (s *CustomFieldsSuite) TestCustomFieldDefinitions_Validate(c *C) {
+	defs := testCustomFieldDefinitions()
+
+	var fields CustomFields
+	fields.SetString("Known via", "Tom")
+	fields.SetNumber("Employees", 120)
+	fields.SetDate("Birthday", time.Date(1980, time.May, 1, 0, 0, 0, 0, time.UTC))
+	fields.SetBool("VIP", true)
+	fields.SetString("Tier", "Gold")
+	fields.SetList("Interests", []string{"Golf", "Tennis"})
+	fields.SetAddress("Billing address", &Address{City: "Hyannis"})
+	fields.Clear("Employees")
+	c.Assert(defs.Validate(fields), IsNil)
+
+	// values decoded from JSON
+	c.Assert(defs.Validate(CustomFields{
+		"Employees": "120",
+		"Interests": []interface{}{"Golf"},
+		"Billing address": map[string]interface{}{
+			"city": "Hyannis",
+		},
+	}), IsNil)
+
+	c.Assert(defs.Validate(CustomFields{"Unknown": "x"}), ErrorMatches, `basecrm: custom field "Unknown": field is not defined`)
+	c.Assert(defs.Validate(CustomFields{"Employees": "many"}), ErrorMatches, `basecrm: custom field "Employees": string is not a valid number value`)
+	c.Assert(defs.Validate(CustomFields{"Birthday": "01/05/1980"}), ErrorMatches, `.*string is not a valid date value`)
+	c.Assert(defs.Validate(CustomFields{"VIP": "yes"}), ErrorMatches, `.*string is not a valid bool value`)
+	c.Assert(defs.Validate(CustomFields{"Tier": "Bronze"}), ErrorMatches, `.*"Bronze" is not one of the choices`)
+	c.Assert(defs.Validate(CustomFields{"Interests": []string{"Golf", "Chess"}}), ErrorMatches, `.*"Chess" is not one of the choices`)
+	c.Assert(defs.Validate(CustomFields{"Billing address": "2726 Smith Street"}), ErrorMatches, `.*string is not a valid address value`)
+}
+
+func (s *CustomFieldsSuite) TestCustomFieldDefinitions_Set(c *C) {
+	defs := testCustomFieldDefinitions()
+
+	var fields CustomFields
+	c.Assert(defs.Set(&fields, "Employees", 120), IsNil)
+	c.Assert(defs.Set(&fields, "Birthday", time.Date(1980, time.May, 1, 12, 0, 0, 0, time.UTC)), IsNil)
+	c.Assert(defs.Set(&fields, "Interests", []string{"Golf"}), IsNil)
+	c.Assert(fields, DeepEquals, CustomFields{
+		"Employees": 120,
+		"Birthday":  "1980-05-01",
+		"Interests": []string{"Golf"},
+	})
+
+	c.Assert(defs.Set(&fields, "Employees", "many"), ErrorMatches, `basecrm: custom field "Employees": string is not a valid number value`)
+	c.Assert(defs.Set(&fields, "Tier", "Bronze"), ErrorMatches, `.*"Bronze" is not one of the choices`)
+	c.Assert(defs.Set(&fields, "Unknown", "x"), ErrorMatches, `.*field is not defined`)
+	c.Assert(fields["Employees"], Equals, 120)
+	_, ok := fields["Tier"]
+	c.Assert(ok, Equals, false)
+}
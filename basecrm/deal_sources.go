@@ -0,0 +1,201 @@
+package basecrm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type DealSource struct {
+	Id               int          `json:"id,omitempty"`
+	CreatorId        int          `json:"creator_id,omitempty"`
+	Name             string       `json:"name,omitempty"`
+	ResourceType     ResourceType `json:"resource_type,omitempty"`
+	MarketingChannel string       `json:"marketing_channel,omitempty"`
+	UpdatedAt        Timestamp    `json:"updated_at,omitempty"`
+	CreatedAt        Timestamp    `json:"created_at,omitempty"`
+
+	ForceSendFields []string `json:"-"`
+	NullFields      []string `json:"-"`
+}
+
+// MarshalJSON encodes the DealSource, sending the fields listed in ForceSendFields and NullFields.
+func (d DealSource) MarshalJSON() ([]byte, error) {
+	type dealSource DealSource
+	return marshalFields(dealSource(d), d.ForceSendFields, d.NullFields)
+}
+
+type DealSourceListOptions struct {
+	CreatorId int    `url:"creator_id,omitempty"`
+	Name      string `url:"name,omitempty"`
+
+	MarketingChannel string `url:"marketing_channel,omitempty"`
+
+	ListOptions
+}
+
+type DealSourcesService interface {
+	List(opt *DealSourceListOptions) ([]*DealSource, *Response, error)
+	ListContext(ctx context.Context, opt *DealSourceListOptions) ([]*DealSource, *Response, error)
+	ListAll(opt *DealSourceListOptions) *Iter[*DealSource]
+	ListAllContext(ctx context.Context, opt *DealSourceListOptions) *Iter[*DealSource]
+	Get(id int) (*DealSource, *Response, error)
+	GetContext(ctx context.Context, id int) (*DealSource, *Response, error)
+	Create(dealSource *DealSource) (*DealSource, *Response, error)
+	CreateContext(ctx context.Context, dealSource *DealSource) (*DealSource, *Response, error)
+	Edit(id int, dealSource *DealSource) (*DealSource, *Response, error)
+	EditContext(ctx context.Context, id int, dealSource *DealSource) (*DealSource, *Response, error)
+	Delete(id int) (bool, *Response, error)
+	DeleteContext(ctx context.Context, id int) (bool, *Response, error)
+}
+
+func NewDealSourcesService(client *Client) DealSourcesService {
+	return &DealSourcesServiceOp{client}
+}
+
+type dealSourceRoot struct {
+	DealSource *DealSource `json:"data"`
+	Meta       *struct {
+		Type string `json:"type,omitempty"`
+	} `json:"meta,omitempty"`
+}
+
+type dealSourcesRoot struct {
+	Items []*dealSourceRoot `json:"items"`
+	Meta  *Meta             `json:"meta"`
+}
+
+func (r *dealSourcesRoot) DealSources() []*DealSource {
+	dealSources := make([]*DealSource, len(r.Items))
+	for i, root := range r.Items {
+		dealSources[i] = root.DealSource
+	}
+	return dealSources
+}
+
+type DealSourcesServiceOp struct {
+	client *Client
+}
+
+func (s *DealSourcesServiceOp) List(opt *DealSourceListOptions) ([]*DealSource, *Response, error) {
+	return s.ListContext(context.Background(), opt)
+}
+
+func (s *DealSourcesServiceOp) ListContext(ctx context.Context, opt *DealSourceListOptions) ([]*DealSource, *Response, error) {
+	u, err := addOptions("/v2/deal_sources", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(dealSourcesRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	res.setMeta(root.Meta)
+
+	return root.DealSources(), res, err
+}
+
+func (s *DealSourcesServiceOp) ListAll(opt *DealSourceListOptions) *Iter[*DealSource] {
+	return s.ListAllContext(context.Background(), opt)
+}
+
+func (s *DealSourcesServiceOp) ListAllContext(ctx context.Context, opt *DealSourceListOptions) *Iter[*DealSource] {
+	o := DealSourceListOptions{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIter(ctx, o.Page, func(ctx context.Context, page int) ([]*DealSource, *Response, error) {
+		o.Page = page
+		return s.ListContext(ctx, &o)
+	})
+}
+
+func (s *DealSourcesServiceOp) Get(id int) (*DealSource, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+func (s *DealSourcesServiceOp) GetContext(ctx context.Context, id int) (*DealSource, *Response, error) {
+	u := fmt.Sprintf("/v2/deal_sources/%d", id)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(dealSourceRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return root.DealSource, res, err
+}
+
+func (s *DealSourcesServiceOp) Create(dealSource *DealSource) (*DealSource, *Response, error) {
+	return s.CreateContext(context.Background(), dealSource)
+}
+
+func (s *DealSourcesServiceOp) CreateContext(ctx context.Context, dealSource *DealSource) (*DealSource, *Response, error) {
+	u := "/v2/deal_sources"
+	envelope := &dealSourceRoot{DealSource: dealSource}
+	req, err := s.client.NewRequestContext(ctx, "POST", u, envelope)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(dealSourceRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return root.DealSource, res, err
+}
+
+func (s *DealSourcesServiceOp) Edit(id int, dealSource *DealSource) (*DealSource, *Response, error) {
+	return s.EditContext(context.Background(), id, dealSource)
+}
+
+func (s *DealSourcesServiceOp) EditContext(ctx context.Context, id int, dealSource *DealSource) (*DealSource, *Response, error) {
+	u := fmt.Sprintf("/v2/deal_sources/%d", id)
+	envelope := &dealSourceRoot{DealSource: dealSource}
+	req, err := s.client.NewRequestContext(ctx, "PUT", u, envelope)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(dealSourceRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return root.DealSource, res, err
+}
+
+func (s *DealSourcesServiceOp) Delete(id int) (bool, *Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+func (s *DealSourcesServiceOp) DeleteContext(ctx context.Context, id int) (bool, *Response, error) {
+	u := fmt.Sprintf("/v2/deal_sources/%d", id)
+	req, err := s.client.NewRequestContext(ctx, "DELETE", u, nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	res, err := s.client.Do(req, nil)
+	if err != nil {
+		return false, res, err
+	}
+
+	return res.StatusCode == http.StatusNoContent, res, err
+}
@@ -0,0 +1,230 @@
+package basecrm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+func TestDealSourcesService(t *testing.T) { TestingT(t) }
+
+type DealSourcesSuite struct {
+}
+
+var _ = Suite(&DealSourcesSuite{})
+
+func (s *DealSourcesSuite) TestDealSourcesService_List_All(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/deal_sources", func(w http.ResponseWriter, req *http.Request) {
+		expected := map[string]string{
+			"creator_id":        "1",
+			"name":              "Word of mouth",
+			"marketing_channel": "referral",
+			"page":              "1",
+			"per_page":          "25",
+			"ids":               "1,2,3",
+			"sort_by":           "name:desc,created_at:asc",
+		}
+		c.Assert(req, HasHttpMethod, "GET")
+		c.Assert(req, HasHttpHeader, "Accept", "application/json")
+		c.Assert(req, HasQueryParams, expected)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+     {
+      "items": [{
+          "data": {
+            "id": 1
+          },
+          "meta": {
+            "type": "deal_source"
+          }
+      }, {
+          "data": {
+            "id": 2
+          },
+          "meta": {
+            "type": "deal_source"
+          }
+      }],
+      "meta": {
+        "type": "collection",
+        "count": 2,
+        "links": {
+          "self": "http://api.getbase.com/v2/deal_sources.json"
+        }
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	opt := &DealSourceListOptions{
+		CreatorId:        1,
+		Name:             "Word of mouth",
+		MarketingChannel: "referral",
+		ListOptions: ListOptions{
+			Page:    1,
+			PerPage: 25,
+			Ids:     []int{1, 2, 3},
+			SortBy:  []string{"name:desc", "created_at:asc"},
+		},
+	}
+	dealSources, res, err := client.DealSources.List(opt)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(dealSources, NotNil)
+
+	c.Assert(len(dealSources), Equals, 2)
+	c.Assert(dealSources[0].Id, Equals, 1)
+	c.Assert(dealSources[1].Id, Equals, 2)
+}
+
+func (s *DealSourcesSuite) TestDealSourcesService_Get(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/deal_sources/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "creator_id": 1,
+        "name": "Word of mouth",
+        "resource_type": "deal",
+        "marketing_channel": "referral",
+        "created_at": "2014-08-27T16:32:56Z",
+        "updated_at": "2014-08-27T16:32:56Z"
+      },
+      "meta": {
+        "type": "deal_source"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	dealSource, res, err := client.DealSources.Get(1)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(dealSource, NotNil)
+
+	c.Assert(dealSource.Id, Equals, 1)
+	c.Assert(dealSource.Name, Equals, "Word of mouth")
+	c.Assert(dealSource.ResourceType, Equals, DealResource)
+	c.Assert(dealSource.MarketingChannel, Equals, "referral")
+}
+
+func (s *DealSourcesSuite) TestDealSourcesService_Create(c *C) {
+	setup()
+	defer teardown()
+
+	input := &DealSource{
+		Name: "Tom referral",
+	}
+
+	expected := &DealSource{
+		Id:   1,
+		Name: "Tom referral",
+	}
+
+	mux.HandleFunc("/v2/deal_sources", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "POST")
+
+		root := new(dealSourceRoot)
+		json.NewDecoder(req.Body).Decode(root)
+		c.Assert(root.DealSource, NotNil)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "name": "Tom referral"
+      },
+      "meta": {
+        "type": "deal_source"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	dealSource, res, err := client.DealSources.Create(input)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(dealSource, NotNil)
+
+	c.Assert(dealSource, DeepEquals, expected)
+}
+
+func (s *DealSourcesSuite) TestDealSourcesService_Edit(c *C) {
+	setup()
+	defer teardown()
+
+	input := &DealSource{
+		Name: "Tom",
+	}
+
+	expected := &DealSource{
+		Id:   1,
+		Name: "Tom",
+	}
+
+	mux.HandleFunc("/v2/deal_sources/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "PUT")
+
+		root := new(dealSourceRoot)
+		json.NewDecoder(req.Body).Decode(root)
+		c.Assert(root.DealSource, NotNil)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "name": "Tom"
+      },
+      "meta": {
+        "type": "deal_source"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	dealSource, res, err := client.DealSources.Edit(1, input)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(dealSource, NotNil)
+
+	c.Assert(dealSource, DeepEquals, expected)
+}
+
+func (s *DealSourcesSuite) TestDealSourcesService_Delete(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/deal_sources/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "DELETE")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	deleted, res, err := client.DealSources.Delete(1)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(deleted, Equals, true)
+}
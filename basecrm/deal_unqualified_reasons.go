@@ -0,0 +1,197 @@
+package basecrm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type DealUnqualifiedReason struct {
+	Id        int       `json:"id,omitempty"`
+	CreatorId int       `json:"creator_id,omitempty"`
+	Name      string    `json:"name,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+
+	ForceSendFields []string `json:"-"`
+	NullFields      []string `json:"-"`
+}
+
+// MarshalJSON encodes the DealUnqualifiedReason, sending the fields listed in ForceSendFields and NullFields.
+func (d DealUnqualifiedReason) MarshalJSON() ([]byte, error) {
+	type dealUnqualifiedReason DealUnqualifiedReason
+	return marshalFields(dealUnqualifiedReason(d), d.ForceSendFields, d.NullFields)
+}
+
+type DealUnqualifiedReasonListOptions struct {
+	CreatorId int    `url:"creator_id,omitempty"`
+	Name      string `url:"name,omitempty"`
+
+	ListOptions
+}
+
+type DealUnqualifiedReasonsService interface {
+	List(opt *DealUnqualifiedReasonListOptions) ([]*DealUnqualifiedReason, *Response, error)
+	ListContext(ctx context.Context, opt *DealUnqualifiedReasonListOptions) ([]*DealUnqualifiedReason, *Response, error)
+	ListAll(opt *DealUnqualifiedReasonListOptions) *Iter[*DealUnqualifiedReason]
+	ListAllContext(ctx context.Context, opt *DealUnqualifiedReasonListOptions) *Iter[*DealUnqualifiedReason]
+	Get(id int) (*DealUnqualifiedReason, *Response, error)
+	GetContext(ctx context.Context, id int) (*DealUnqualifiedReason, *Response, error)
+	Create(dealUnqualifiedReason *DealUnqualifiedReason) (*DealUnqualifiedReason, *Response, error)
+	CreateContext(ctx context.Context, dealUnqualifiedReason *DealUnqualifiedReason) (*DealUnqualifiedReason, *Response, error)
+	Edit(id int, dealUnqualifiedReason *DealUnqualifiedReason) (*DealUnqualifiedReason, *Response, error)
+	EditContext(ctx context.Context, id int, dealUnqualifiedReason *DealUnqualifiedReason) (*DealUnqualifiedReason, *Response, error)
+	Delete(id int) (bool, *Response, error)
+	DeleteContext(ctx context.Context, id int) (bool, *Response, error)
+}
+
+func NewDealUnqualifiedReasonsService(client *Client) DealUnqualifiedReasonsService {
+	return &DealUnqualifiedReasonsServiceOp{client}
+}
+
+type dealUnqualifiedReasonRoot struct {
+	DealUnqualifiedReason *DealUnqualifiedReason `json:"data"`
+	Meta                  *struct {
+		Type string `json:"type,omitempty"`
+	} `json:"meta,omitempty"`
+}
+
+type dealUnqualifiedReasonsRoot struct {
+	Items []*dealUnqualifiedReasonRoot `json:"items"`
+	Meta  *Meta                        `json:"meta"`
+}
+
+func (r *dealUnqualifiedReasonsRoot) DealUnqualifiedReasons() []*DealUnqualifiedReason {
+	dealUnqualifiedReasons := make([]*DealUnqualifiedReason, len(r.Items))
+	for i, root := range r.Items {
+		dealUnqualifiedReasons[i] = root.DealUnqualifiedReason
+	}
+	return dealUnqualifiedReasons
+}
+
+type DealUnqualifiedReasonsServiceOp struct {
+	client *Client
+}
+
+func (s *DealUnqualifiedReasonsServiceOp) List(opt *DealUnqualifiedReasonListOptions) ([]*DealUnqualifiedReason, *Response, error) {
+	return s.ListContext(context.Background(), opt)
+}
+
+func (s *DealUnqualifiedReasonsServiceOp) ListContext(ctx context.Context, opt *DealUnqualifiedReasonListOptions) ([]*DealUnqualifiedReason, *Response, error) {
+	u, err := addOptions("/v2/deal_unqualified_reasons", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(dealUnqualifiedReasonsRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	res.setMeta(root.Meta)
+
+	return root.DealUnqualifiedReasons(), res, err
+}
+
+func (s *DealUnqualifiedReasonsServiceOp) ListAll(opt *DealUnqualifiedReasonListOptions) *Iter[*DealUnqualifiedReason] {
+	return s.ListAllContext(context.Background(), opt)
+}
+
+func (s *DealUnqualifiedReasonsServiceOp) ListAllContext(ctx context.Context, opt *DealUnqualifiedReasonListOptions) *Iter[*DealUnqualifiedReason] {
+	o := DealUnqualifiedReasonListOptions{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIter(ctx, o.Page, func(ctx context.Context, page int) ([]*DealUnqualifiedReason, *Response, error) {
+		o.Page = page
+		return s.ListContext(ctx, &o)
+	})
+}
+
+func (s *DealUnqualifiedReasonsServiceOp) Get(id int) (*DealUnqualifiedReason, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+func (s *DealUnqualifiedReasonsServiceOp) GetContext(ctx context.Context, id int) (*DealUnqualifiedReason, *Response, error) {
+	u := fmt.Sprintf("/v2/deal_unqualified_reasons/%d", id)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(dealUnqualifiedReasonRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return root.DealUnqualifiedReason, res, err
+}
+
+func (s *DealUnqualifiedReasonsServiceOp) Create(dealUnqualifiedReason *DealUnqualifiedReason) (*DealUnqualifiedReason, *Response, error) {
+	return s.CreateContext(context.Background(), dealUnqualifiedReason)
+}
+
+func (s *DealUnqualifiedReasonsServiceOp) CreateContext(ctx context.Context, dealUnqualifiedReason *DealUnqualifiedReason) (*DealUnqualifiedReason, *Response, error) {
+	u := "/v2/deal_unqualified_reasons"
+	envelope := &dealUnqualifiedReasonRoot{DealUnqualifiedReason: dealUnqualifiedReason}
+	req, err := s.client.NewRequestContext(ctx, "POST", u, envelope)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(dealUnqualifiedReasonRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return root.DealUnqualifiedReason, res, err
+}
+
+func (s *DealUnqualifiedReasonsServiceOp) Edit(id int, dealUnqualifiedReason *DealUnqualifiedReason) (*DealUnqualifiedReason, *Response, error) {
+	return s.EditContext(context.Background(), id, dealUnqualifiedReason)
+}
+
+func (s *DealUnqualifiedReasonsServiceOp) EditContext(ctx context.Context, id int, dealUnqualifiedReason *DealUnqualifiedReason) (*DealUnqualifiedReason, *Response, error) {
+	u := fmt.Sprintf("/v2/deal_unqualified_reasons/%d", id)
+	envelope := &dealUnqualifiedReasonRoot{DealUnqualifiedReason: dealUnqualifiedReason}
+	req, err := s.client.NewRequestContext(ctx, "PUT", u, envelope)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(dealUnqualifiedReasonRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return root.DealUnqualifiedReason, res, err
+}
+
+func (s *DealUnqualifiedReasonsServiceOp) Delete(id int) (bool, *Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+func (s *DealUnqualifiedReasonsServiceOp) DeleteContext(ctx context.Context, id int) (bool, *Response, error) {
+	u := fmt.Sprintf("/v2/deal_unqualified_reasons/%d", id)
+	req, err := s.client.NewRequestContext(ctx, "DELETE", u, nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	res, err := s.client.Do(req, nil)
+	if err != nil {
+		return false, res, err
+	}
+
+	return res.StatusCode == http.StatusNoContent, res, err
+}
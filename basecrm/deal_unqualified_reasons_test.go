@@ -0,0 +1,224 @@
+package basecrm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+func TestDealUnqualifiedReasonsService(t *testing.T) { TestingT(t) }
+
+type DealUnqualifiedReasonsSuite struct {
+}
+
+var _ = Suite(&DealUnqualifiedReasonsSuite{})
+
+func (s *DealUnqualifiedReasonsSuite) TestDealUnqualifiedReasonsService_List_All(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/deal_unqualified_reasons", func(w http.ResponseWriter, req *http.Request) {
+		expected := map[string]string{
+			"creator_id": "1",
+			"name":       "We were too expensive",
+			"page":       "1",
+			"per_page":   "25",
+			"ids":        "1,2,3",
+			"sort_by":    "name:desc,created_at:asc",
+		}
+		c.Assert(req, HasHttpMethod, "GET")
+		c.Assert(req, HasHttpHeader, "Accept", "application/json")
+		c.Assert(req, HasQueryParams, expected)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+     {
+      "items": [{
+          "data": {
+            "id": 1
+          },
+          "meta": {
+            "type": "deal_unqualified_reason"
+          }
+      }, {
+          "data": {
+            "id": 2
+          },
+          "meta": {
+            "type": "deal_unqualified_reason"
+          }
+      }],
+      "meta": {
+        "type": "collection",
+        "count": 2,
+        "links": {
+          "self": "http://api.getbase.com/v2/deal_unqualified_reasons.json"
+        }
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	opt := &DealUnqualifiedReasonListOptions{
+		CreatorId: 1,
+		Name:      "We were too expensive",
+		ListOptions: ListOptions{
+			Page:    1,
+			PerPage: 25,
+			Ids:     []int{1, 2, 3},
+			SortBy:  []string{"name:desc", "created_at:asc"},
+		},
+	}
+	dealUnqualifiedReasons, res, err := client.DealUnqualifiedReasons.List(opt)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(dealUnqualifiedReasons, NotNil)
+
+	c.Assert(len(dealUnqualifiedReasons), Equals, 2)
+	c.Assert(dealUnqualifiedReasons[0].Id, Equals, 1)
+	c.Assert(dealUnqualifiedReasons[1].Id, Equals, 2)
+}
+
+func (s *DealUnqualifiedReasonsSuite) TestDealUnqualifiedReasonsService_Get(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/deal_unqualified_reasons/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "creator_id": 1,
+        "name": "We were too expensive",
+        "created_at": "2014-08-27T16:32:56Z",
+        "updated_at": "2014-08-27T16:32:56Z"
+      },
+      "meta": {
+        "type": "deal_unqualified_reason"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	dealUnqualifiedReason, res, err := client.DealUnqualifiedReasons.Get(1)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(dealUnqualifiedReason, NotNil)
+
+	c.Assert(dealUnqualifiedReason.Id, Equals, 1)
+	c.Assert(dealUnqualifiedReason.Name, Equals, "We were too expensive")
+}
+
+func (s *DealUnqualifiedReasonsSuite) TestDealUnqualifiedReasonsService_Create(c *C) {
+	setup()
+	defer teardown()
+
+	input := &DealUnqualifiedReason{
+		Name: "We were too expensive",
+	}
+
+	expected := &DealUnqualifiedReason{
+		Id:   1,
+		Name: "We were too expensive",
+	}
+
+	mux.HandleFunc("/v2/deal_unqualified_reasons", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "POST")
+
+		root := new(dealUnqualifiedReasonRoot)
+		json.NewDecoder(req.Body).Decode(root)
+		c.Assert(root.DealUnqualifiedReason, NotNil)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "name": "We were too expensive"
+      },
+      "meta": {
+        "type": "deal_unqualified_reason"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	dealUnqualifiedReason, res, err := client.DealUnqualifiedReasons.Create(input)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(dealUnqualifiedReason, NotNil)
+
+	c.Assert(dealUnqualifiedReason, DeepEquals, expected)
+}
+
+func (s *DealUnqualifiedReasonsSuite) TestDealUnqualifiedReasonsService_Edit(c *C) {
+	setup()
+	defer teardown()
+
+	input := &DealUnqualifiedReason{
+		Name: "We were not ready",
+	}
+
+	expected := &DealUnqualifiedReason{
+		Id:   1,
+		Name: "We were not ready",
+	}
+
+	mux.HandleFunc("/v2/deal_unqualified_reasons/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "PUT")
+
+		root := new(dealUnqualifiedReasonRoot)
+		json.NewDecoder(req.Body).Decode(root)
+		c.Assert(root.DealUnqualifiedReason, NotNil)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "name": "We were not ready"
+      },
+      "meta": {
+        "type": "deal_unqualified_reason"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	dealUnqualifiedReason, res, err := client.DealUnqualifiedReasons.Edit(1, input)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(dealUnqualifiedReason, NotNil)
+
+	c.Assert(dealUnqualifiedReason, DeepEquals, expected)
+}
+
+func (s *DealUnqualifiedReasonsSuite) TestDealUnqualifiedReasonsService_Delete(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/deal_unqualified_reasons/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "DELETE")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	deleted, res, err := client.DealUnqualifiedReasons.Delete(1)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(deleted, Equals, true)
+}
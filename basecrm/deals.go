@@ -1,33 +1,74 @@
 package basecrm
 
 import (
+	"context"
 	"fmt"
 	"net/http"
-	"time"
 )
 
 type AssociatedContact struct {
-	CreatorId int    `json:"creator_id,omitempty"`
-	ContactId int    `json:"contact_id,omitempty"`
-	Role      string `json:"role,omitempty"`
+	CreatorId int       `json:"creator_id,omitempty"`
+	ContactId int       `json:"contact_id,omitempty"`
+	Role      string    `json:"role,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+}
+
+// MarshalJSON encodes the AssociatedContact, omitting its unset timestamps.
+func (a AssociatedContact) MarshalJSON() ([]byte, error) {
+	type associatedContact AssociatedContact
+	return marshalFields(associatedContact(a), nil, nil)
+}
+
+type AssociatedContactListOptions struct {
+	Role string `url:"role,omitempty"`
+
+	ListOptions
 }
 
 type Deal struct {
-	Id                 int                    `json:"id,omitempty"`
-	CreatorId          int                    `json:"creator_id,omitempty"`
-	OwnerId            int                    `json:"owner_id,omitempty"`
-	Name               string                 `json:"name,omitempty"`
-	Value              int                    `json:"value,omitempty"`
-	Currency           string                 `json:"currency,omitempty"`
-	Hot                bool                   `json:"hot,omitempty"`
-	SourceId           int                    `json:"source_id,omitempty"`
-	LossReasonId       int                    `json:"loss_reason_id,omitempty"`
-	AssociatedContacts []*AssociatedContact   `json:"associated_contacts,omitempty"`
-	DropboxEmail       string                 `json:"dropbox_email,omitempty"`
-	Tags               []string               `json:"tags,omitempty"`
-	CustomFields       map[string]interface{} `json:"custom_fields,omitempty"`
-	UpdatedAt          time.Time              `json:"updated_at,omitempty"`
-	CreatedAt          time.Time              `json:"created_at,omitempty"`
+	Id                  int                  `json:"id,omitempty"`
+	CreatorId           int                  `json:"creator_id,omitempty"`
+	OwnerId             int                  `json:"owner_id,omitempty"`
+	ContactId           int                  `json:"contact_id,omitempty"`
+	Name                string               `json:"name,omitempty"`
+	Value               Decimal              `json:"value,omitempty"`
+	Currency            string               `json:"currency,omitempty"`
+	Hot                 bool                 `json:"hot,omitempty"`
+	StageId             int                  `json:"stage_id,omitempty"`
+	SourceId            int                  `json:"source_id,omitempty"`
+	LossReasonId        int                  `json:"loss_reason_id,omitempty"`
+	UnqualifiedReasonId int                  `json:"unqualified_reason_id,omitempty"`
+	AssociatedContacts  []*AssociatedContact `json:"associated_contacts,omitempty"`
+	DropboxEmail        string               `json:"dropbox_email,omitempty"`
+	Tags                []string             `json:"tags,omitempty"`
+	CustomFields        CustomFields         `json:"custom_fields,omitempty"`
+	EstimatedCloseDate  Date                 `json:"estimated_close_date,omitempty"`
+	LastStageChangeAt   Timestamp            `json:"last_stage_change_at,omitempty"`
+	LastStageChangeById int                  `json:"last_stage_change_by_id,omitempty"`
+	LastActivityAt      Timestamp            `json:"last_activity_at,omitempty"`
+	UpdatedAt           Timestamp            `json:"updated_at,omitempty"`
+	CreatedAt           Timestamp            `json:"created_at,omitempty"`
+
+	ForceSendFields []string `json:"-"`
+	NullFields      []string `json:"-"`
+}
+
+// MarshalJSON encodes the Deal, sending the fields listed in ForceSendFields and NullFields.
+func (d Deal) MarshalJSON() ([]byte, error) {
+	type deal Deal
+	return marshalFields(deal(d), d.ForceSendFields, d.NullFields)
+}
+
+// Money returns the value of the deal in its currency.
+func (d *Deal) Money() Money {
+	return Money{Amount: d.Value, Currency: d.Currency}
+}
+
+// SetMoney sets the value and the currency of the deal.
+func (d *Deal) SetMoney(m Money) {
+	d.Value = m.Amount
+	d.Currency = m.Currency
 }
 
 type DealListOptions struct {
@@ -37,6 +78,7 @@ type DealListOptions struct {
 	CreatorId int `url:"creator_id,omitempty"`
 	OwnerId   int `url:"owner_id,omitempty"`
 	ContactId int `url:"contact_id,omitempty"`
+	StageId   int `url:"stage_id,omitempty"`
 
 	SourceId     int `url:"source_id,omitempty"`
 	LossReasonId int `url:"loss_reason_id,omitempty"`
@@ -48,12 +90,25 @@ type DealListOptions struct {
 
 type DealsService interface {
 	List(opt *DealListOptions) ([]*Deal, *Response, error)
+	ListContext(ctx context.Context, opt *DealListOptions) ([]*Deal, *Response, error)
+	ListAll(opt *DealListOptions) *Iter[*Deal]
+	ListAllContext(ctx context.Context, opt *DealListOptions) *Iter[*Deal]
 	Get(id int) (*Deal, *Response, error)
+	GetContext(ctx context.Context, id int) (*Deal, *Response, error)
 	Create(deal *Deal) (*Deal, *Response, error)
+	CreateContext(ctx context.Context, deal *Deal) (*Deal, *Response, error)
 	Edit(id int, deal *Deal) (*Deal, *Response, error)
+	EditContext(ctx context.Context, id int, deal *Deal) (*Deal, *Response, error)
 	Delete(id int) (bool, *Response, error)
+	DeleteContext(ctx context.Context, id int) (bool, *Response, error)
+	ListContacts(id int, opt *AssociatedContactListOptions) ([]*AssociatedContact, *Response, error)
+	ListContactsContext(ctx context.Context, id int, opt *AssociatedContactListOptions) ([]*AssociatedContact, *Response, error)
+	ListAllContacts(id int, opt *AssociatedContactListOptions) *Iter[*AssociatedContact]
+	ListAllContactsContext(ctx context.Context, id int, opt *AssociatedContactListOptions) *Iter[*AssociatedContact]
 	UpsertContact(id int, contact *AssociatedContact) (bool, *Response, error)
+	UpsertContactContext(ctx context.Context, id int, contact *AssociatedContact) (bool, *Response, error)
 	DeleteContact(id, contactId int) (bool, *Response, error)
+	DeleteContactContext(ctx context.Context, id, contactId int) (bool, *Response, error)
 }
 
 func NewDealsService(client *Client) DealsService {
@@ -80,17 +135,41 @@ func (r *dealsRoot) Deals() []*Deal {
 	return deals
 }
 
+type associatedContactRoot struct {
+	AssociatedContact *AssociatedContact `json:"data"`
+	Meta              *struct {
+		Type string `json:"type,omitempty"`
+	} `json:"meta,omitempty"`
+}
+
+type associatedContactsRoot struct {
+	Items []*associatedContactRoot `json:"items"`
+	Meta  *Meta                    `json:"meta"`
+}
+
+func (r *associatedContactsRoot) AssociatedContacts() []*AssociatedContact {
+	contacts := make([]*AssociatedContact, len(r.Items))
+	for i, root := range r.Items {
+		contacts[i] = root.AssociatedContact
+	}
+	return contacts
+}
+
 type DealsServiceOp struct {
 	client *Client
 }
 
 func (s *DealsServiceOp) List(opt *DealListOptions) ([]*Deal, *Response, error) {
+	return s.ListContext(context.Background(), opt)
+}
+
+func (s *DealsServiceOp) ListContext(ctx context.Context, opt *DealListOptions) ([]*Deal, *Response, error) {
 	u, err := addOptions("/v2/deals", opt)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -101,12 +180,34 @@ func (s *DealsServiceOp) List(opt *DealListOptions) ([]*Deal, *Response, error)
 		return nil, res, err
 	}
 
+	res.setMeta(root.Meta)
+
 	return root.Deals(), res, err
 }
 
+func (s *DealsServiceOp) ListAll(opt *DealListOptions) *Iter[*Deal] {
+	return s.ListAllContext(context.Background(), opt)
+}
+
+func (s *DealsServiceOp) ListAllContext(ctx context.Context, opt *DealListOptions) *Iter[*Deal] {
+	o := DealListOptions{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIter(ctx, o.Page, func(ctx context.Context, page int) ([]*Deal, *Response, error) {
+		o.Page = page
+		return s.ListContext(ctx, &o)
+	})
+}
+
 func (s *DealsServiceOp) Get(id int) (*Deal, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+func (s *DealsServiceOp) GetContext(ctx context.Context, id int) (*Deal, *Response, error) {
 	u := fmt.Sprintf("/v2/deals/%d", id)
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -121,9 +222,13 @@ func (s *DealsServiceOp) Get(id int) (*Deal, *Response, error) {
 }
 
 func (s *DealsServiceOp) Create(deal *Deal) (*Deal, *Response, error) {
+	return s.CreateContext(context.Background(), deal)
+}
+
+func (s *DealsServiceOp) CreateContext(ctx context.Context, deal *Deal) (*Deal, *Response, error) {
 	u := "/v2/deals"
 	envelope := &dealRoot{Deal: deal}
-	req, err := s.client.NewRequest("POST", u, envelope)
+	req, err := s.client.NewRequestContext(ctx, "POST", u, envelope)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -138,9 +243,13 @@ func (s *DealsServiceOp) Create(deal *Deal) (*Deal, *Response, error) {
 }
 
 func (s *DealsServiceOp) Edit(id int, deal *Deal) (*Deal, *Response, error) {
+	return s.EditContext(context.Background(), id, deal)
+}
+
+func (s *DealsServiceOp) EditContext(ctx context.Context, id int, deal *Deal) (*Deal, *Response, error) {
 	u := fmt.Sprintf("/v2/deals/%d", id)
 	envelope := &dealRoot{Deal: deal}
-	req, err := s.client.NewRequest("PUT", u, envelope)
+	req, err := s.client.NewRequestContext(ctx, "PUT", u, envelope)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -155,8 +264,12 @@ func (s *DealsServiceOp) Edit(id int, deal *Deal) (*Deal, *Response, error) {
 }
 
 func (s *DealsServiceOp) Delete(id int) (bool, *Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+func (s *DealsServiceOp) DeleteContext(ctx context.Context, id int) (bool, *Response, error) {
 	u := fmt.Sprintf("/v2/deals/%d", id)
-	req, err := s.client.NewRequest("DELETE", u, nil)
+	req, err := s.client.NewRequestContext(ctx, "DELETE", u, nil)
 	if err != nil {
 		return false, nil, err
 	}
@@ -169,9 +282,55 @@ func (s *DealsServiceOp) Delete(id int) (bool, *Response, error) {
 	return res.StatusCode == http.StatusNoContent, res, err
 }
 
+func (s *DealsServiceOp) ListContacts(id int, opt *AssociatedContactListOptions) ([]*AssociatedContact, *Response, error) {
+	return s.ListContactsContext(context.Background(), id, opt)
+}
+
+func (s *DealsServiceOp) ListContactsContext(ctx context.Context, id int, opt *AssociatedContactListOptions) ([]*AssociatedContact, *Response, error) {
+	u, err := addOptions(fmt.Sprintf("/v2/deals/%d/associated_contacts", id), opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(associatedContactsRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	res.setMeta(root.Meta)
+
+	return root.AssociatedContacts(), res, err
+}
+
+func (s *DealsServiceOp) ListAllContacts(id int, opt *AssociatedContactListOptions) *Iter[*AssociatedContact] {
+	return s.ListAllContactsContext(context.Background(), id, opt)
+}
+
+func (s *DealsServiceOp) ListAllContactsContext(ctx context.Context, id int, opt *AssociatedContactListOptions) *Iter[*AssociatedContact] {
+	o := AssociatedContactListOptions{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIter(ctx, o.Page, func(ctx context.Context, page int) ([]*AssociatedContact, *Response, error) {
+		o.Page = page
+		return s.ListContactsContext(ctx, id, &o)
+	})
+}
+
 func (s *DealsServiceOp) UpsertContact(id int, contact *AssociatedContact) (bool, *Response, error) {
+	return s.UpsertContactContext(context.Background(), id, contact)
+}
+
+func (s *DealsServiceOp) UpsertContactContext(ctx context.Context, id int, contact *AssociatedContact) (bool, *Response, error) {
 	u := fmt.Sprintf("/v2/deals/%d/associated_contacts/%d?role=%s", id, contact.ContactId, contact.Role)
-	req, err := s.client.NewRequest("PUT", u, nil)
+	req, err := s.client.NewRequestContext(ctx, "PUT", u, nil)
 	if err != nil {
 		return false, nil, err
 	}
@@ -185,8 +344,12 @@ func (s *DealsServiceOp) UpsertContact(id int, contact *AssociatedContact) (bool
 }
 
 func (s *DealsServiceOp) DeleteContact(id, contactId int) (bool, *Response, error) {
+	return s.DeleteContactContext(context.Background(), id, contactId)
+}
+
+func (s *DealsServiceOp) DeleteContactContext(ctx context.Context, id, contactId int) (bool, *Response, error) {
 	u := fmt.Sprintf("/v2/deals/%d/associated_contacts/%d", id, contactId)
-	req, err := s.client.NewRequest("DELETE", u, nil)
+	req, err := s.client.NewRequestContext(ctx, "DELETE", u, nil)
 	if err != nil {
 		return false, nil, err
 	}
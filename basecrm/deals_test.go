@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	. "gopkg.in/check.v1"
 )
@@ -27,6 +28,7 @@ func (s *DealsSuite) TestDealsService_List_All(c *C) {
 			"creator_id":     "1",
 			"owner_id":       "1",
 			"contact_id":     "1",
+			"stage_id":       "1",
 			"source_id":      "1",
 			"loss_reason_id": "1",
 			"hot":            "true",
@@ -71,15 +73,16 @@ func (s *DealsSuite) TestDealsService_List_All(c *C) {
 	})
 
 	opt := &DealListOptions{
-		"website",
-		"Website redesign",
-		1,
-		1,
-		1,
-		1,
-		1,
-		true,
-		ListOptions{
+		Q:            "website",
+		Name:         "Website redesign",
+		CreatorId:    1,
+		OwnerId:      1,
+		ContactId:    1,
+		StageId:      1,
+		SourceId:     1,
+		LossReasonId: 1,
+		Hot:          true,
+		ListOptions: ListOptions{
 			Page:    1,
 			PerPage: 25,
 			Ids:     []int{1, 2, 3},
@@ -283,3 +286,100 @@ func (s *DealsSuite) TestDealsService_DeleteContact(c *C) {
 	c.Assert(res, NotNil)
 	c.Assert(deleted, Equals, true)
 }
+
+func (s *DealsSuite) TestDealsService_Get_Stage(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/deals/1", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "stage_id": 3,
+        "last_stage_change_at": "2014-09-28T16:32:56Z",
+        "last_stage_change_by_id": 2
+      },
+      "meta": {
+          "type": "deal"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	deal, _, err := client.Deals.Get(1)
+	c.Assert(err, IsNil)
+
+	c.Assert(deal.StageId, Equals, 3)
+	c.Assert(deal.LastStageChangeById, Equals, 2)
+	c.Assert(deal.LastStageChangeAt.Time, Equals, time.Date(2014, time.September, 28, 16, 32, 56, 0, time.UTC))
+}
+
+func (s *DealsSuite) TestDealsService_ListContacts(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/deals/1/associated_contacts", func(w http.ResponseWriter, req *http.Request) {
+		expected := map[string]string{
+			"role":     "involved",
+			"page":     "1",
+			"per_page": "25",
+		}
+		c.Assert(req, HasHttpMethod, "GET")
+		c.Assert(req, HasQueryParams, expected)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "items": [{
+        "data": {
+          "contact_id": 1,
+          "role": "involved",
+          "created_at": "2014-09-28T16:32:56Z",
+          "updated_at": "2014-09-28T16:32:56Z"
+        },
+        "meta": {
+          "type": "associated_contact"
+        }
+      }, {
+        "data": {
+          "contact_id": 2,
+          "role": "involved"
+        },
+        "meta": {
+          "type": "associated_contact"
+        }
+      }],
+      "meta": {
+        "type": "collection",
+        "count": 2,
+        "links": {
+          "self": "http://api.getbase.com/v2/deals/1/associated_contacts.json?page=1&per_page=25"
+        }
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	opt := &AssociatedContactListOptions{
+		Role: "involved",
+		ListOptions: ListOptions{
+			Page:    1,
+			PerPage: 25,
+		},
+	}
+	contacts, res, err := client.Deals.ListContacts(1, opt)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(res.Meta, NotNil)
+
+	c.Assert(len(contacts), Equals, 2)
+	c.Assert(contacts[0].ContactId, Equals, 1)
+	c.Assert(contacts[0].Role, Equals, "involved")
+	c.Assert(contacts[1].ContactId, Equals, 2)
+}
@@ -0,0 +1,315 @@
+package basecrm
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+const (
+	// maxScale is the maximum number of decimal places of a decimal.
+	maxScale = 18
+	// maxExponent bounds the exponent of parsed decimals, so a short text such as
+	// "1e999999999" cannot expand into a huge number.
+	maxExponent = 1000
+)
+
+// Decimal is an exact decimal number, such as a deal value or a product price.
+// The API sends decimals either as JSON numbers or as JSON strings; both are decoded,
+// and decimals are encoded as JSON numbers keeping their decimal places.
+//
+// The zero value is 0. Decimals have up to 18 decimal places; parsed numbers and
+// products with more are rounded. Use Equal rather than == to compare decimals.
+type Decimal struct {
+	value int64
+	scale int32
+	// big holds the unscaled value instead of value when it does not fit in an int64.
+	big *big.Int
+}
+
+// NewDecimal returns the decimal value × 10^-scale, e.g. NewDecimal(100050, 2) is 1000.50.
+func NewDecimal(value int64, scale int32) Decimal {
+	if scale < 0 {
+		return newDecimal(new(big.Int).Mul(big.NewInt(value), bigPow10(-scale)), 0)
+	}
+	return newDecimal(big.NewInt(value), scale)
+}
+
+// newDecimal returns the decimal x × 10^-scale, rounded to maxScale decimal places.
+func newDecimal(x *big.Int, scale int32) Decimal {
+	if scale > maxScale {
+		x = roundDiv(x, bigPow10(scale-maxScale))
+		scale = maxScale
+	}
+	if x.IsInt64() {
+		return Decimal{value: x.Int64(), scale: scale}
+	}
+	return Decimal{scale: scale, big: x}
+}
+
+// ParseDecimal parses a decimal number, such as "1000.50", "-3" or "1.5e3". Numbers
+// with more than 18 decimal places are rounded half away from zero.
+func ParseDecimal(s string) (Decimal, error) {
+	mantissa, exponent, hasExponent := strings.Cut(strings.ToLower(s), "e")
+
+	var exp int64
+	if hasExponent {
+		var err error
+		if exp, err = strconv.ParseInt(exponent, 10, 32); err != nil {
+			return Decimal{}, fmt.Errorf("basecrm: invalid decimal %q", s)
+		}
+	}
+
+	sign := ""
+	if mantissa != "" && (mantissa[0] == '-' || mantissa[0] == '+') {
+		sign, mantissa = mantissa[:1], mantissa[1:]
+	}
+
+	whole, frac, _ := strings.Cut(mantissa, ".")
+	digits := whole + frac
+	if digits == "" || strings.TrimLeft(digits, "0123456789") != "" {
+		return Decimal{}, fmt.Errorf("basecrm: invalid decimal %q", s)
+	}
+
+	scale := int64(len(frac)) - exp
+	switch {
+	case scale < -maxExponent:
+		return Decimal{}, fmt.Errorf("basecrm: decimal %q out of range", s)
+	case scale > maxScale+int64(len(digits)):
+		// less than half of the smallest decimal, so it rounds to 0
+		return Decimal{scale: maxScale}, nil
+	}
+
+	value, _ := new(big.Int).SetString(sign+digits, 10)
+	if scale < 0 {
+		return newDecimal(value.Mul(value, bigPow10(int32(-scale))), 0), nil
+	}
+	return newDecimal(value, int32(scale)), nil
+}
+
+// MustParseDecimal is like ParseDecimal but panics if s is not a decimal number.
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// Add returns d + d2.
+func (d Decimal) Add(d2 Decimal) Decimal {
+	scale := commonScale(d, d2)
+	if d.big == nil && d2.big == nil {
+		a, ok1 := d.rescale(scale)
+		b, ok2 := d2.rescale(scale)
+		if c := a + b; ok1 && ok2 && (c > a) == (b > 0) {
+			return Decimal{value: c, scale: scale}
+		}
+	}
+	return newDecimal(new(big.Int).Add(d.bigRescale(scale), d2.bigRescale(scale)), scale)
+}
+
+// Sub returns d - d2.
+func (d Decimal) Sub(d2 Decimal) Decimal {
+	return d.Add(d2.Neg())
+}
+
+// Mul returns d × d2, rounded half away from zero to 18 decimal places.
+func (d Decimal) Mul(d2 Decimal) Decimal {
+	scale := d.scale + d2.scale
+	if d.big == nil && d2.big == nil && scale <= maxScale && !overflowsMul(d.value, d2.value) {
+		return Decimal{value: d.value * d2.value, scale: scale}
+	}
+	return newDecimal(new(big.Int).Mul(d.unscaled(), d2.unscaled()), scale)
+}
+
+// Neg returns -d.
+func (d Decimal) Neg() Decimal {
+	if d.big == nil && d.value != -1<<63 {
+		return Decimal{value: -d.value, scale: d.scale}
+	}
+	return newDecimal(new(big.Int).Neg(d.unscaled()), d.scale)
+}
+
+// Round returns d rounded half away from zero to the given number of decimal places.
+// Rounding to more places than d has pads it with zeros, up to 18 places.
+func (d Decimal) Round(places int32) Decimal {
+	if places < 0 {
+		places = 0
+	} else if places > maxScale {
+		places = maxScale
+	}
+	if places >= d.scale {
+		return newDecimal(d.bigRescale(places), places)
+	}
+	return newDecimal(roundDiv(d.unscaled(), bigPow10(d.scale-places)), places)
+}
+
+// Cmp compares d and d2 and returns -1, 0 or +1 if d is less than, equal to or greater than d2.
+func (d Decimal) Cmp(d2 Decimal) int {
+	scale := commonScale(d, d2)
+	if d.big == nil && d2.big == nil {
+		a, ok1 := d.rescale(scale)
+		b, ok2 := d2.rescale(scale)
+		if ok1 && ok2 {
+			switch {
+			case a < b:
+				return -1
+			case a > b:
+				return 1
+			}
+			return 0
+		}
+	}
+	return d.bigRescale(scale).Cmp(d2.bigRescale(scale))
+}
+
+// Equal reports whether d and d2 are the same number, regardless of their decimal places.
+func (d Decimal) Equal(d2 Decimal) bool {
+	return d.Cmp(d2) == 0
+}
+
+// Sign returns -1, 0 or +1 if d is negative, zero or positive.
+func (d Decimal) Sign() int {
+	if d.big != nil {
+		return d.big.Sign()
+	}
+	switch {
+	case d.value < 0:
+		return -1
+	case d.value > 0:
+		return 1
+	}
+	return 0
+}
+
+// IsZero reports whether d is 0.
+func (d Decimal) IsZero() bool {
+	return d.big == nil && d.value == 0
+}
+
+// Float64 returns the nearest float64 value of d.
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// String formats d with all its decimal places, e.g. "1000.50".
+func (d Decimal) String() string {
+	s := d.unscaled().String()
+	if d.scale == 0 {
+		return s
+	}
+
+	sign := ""
+	if s[0] == '-' {
+		sign, s = "-", s[1:]
+	}
+	if len(s) <= int(d.scale) {
+		s = strings.Repeat("0", int(d.scale)-len(s)+1) + s
+	}
+	return sign + s[:len(s)-int(d.scale)] + "." + s[len(s)-int(d.scale):]
+}
+
+// StringFixed formats d rounded to the given number of decimal places, e.g. "1000.50"
+// for 1000.5 and 2 places.
+func (d Decimal) StringFixed(places int32) string {
+	return d.Round(places).String()
+}
+
+// MarshalJSON encodes d as a JSON number.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalJSON decodes a JSON number or string. Null and empty strings decode as 0.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		*d = Decimal{}
+		return nil
+	}
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+	return d.UnmarshalText([]byte(s))
+}
+
+// MarshalText formats d like String.
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses a decimal number. An empty text decodes as 0.
+func (d *Decimal) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*d = Decimal{}
+		return nil
+	}
+
+	parsed, err := ParseDecimal(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// commonScale returns the number of decimal places of d or d2, whichever has more.
+func commonScale(d, d2 Decimal) int32 {
+	if d.scale > d2.scale {
+		return d.scale
+	}
+	return d2.scale
+}
+
+// unscaled returns the value of d without its decimal point, e.g. 100050 for 1000.50.
+func (d Decimal) unscaled() *big.Int {
+	if d.big != nil {
+		return d.big
+	}
+	return big.NewInt(d.value)
+}
+
+// rescale returns the unscaled value of d with the given number of decimal places,
+// which must not be less than its own. It reports false if the value overflows.
+func (d Decimal) rescale(scale int32) (int64, bool) {
+	if scale == d.scale {
+		return d.value, true
+	}
+	p := int64(1)
+	for n := scale - d.scale; n > 0; n-- {
+		p *= 10
+	}
+	if overflowsMul(d.value, p) {
+		return 0, false
+	}
+	return d.value * p, true
+}
+
+// bigRescale is like rescale, for decimals of any size.
+func (d Decimal) bigRescale(scale int32) *big.Int {
+	return new(big.Int).Mul(d.unscaled(), bigPow10(scale-d.scale))
+}
+
+func bigPow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// roundDiv returns x / y rounded half away from zero.
+func roundDiv(x, y *big.Int) *big.Int {
+	q, r := new(big.Int).QuoRem(x, y, new(big.Int))
+	if r.Abs(r).Lsh(r, 1).Cmp(y) >= 0 {
+		q.Add(q, big.NewInt(int64(x.Sign())))
+	}
+	return q
+}
+
+func overflowsMul(a, b int64) bool {
+	if a == 0 || b == 0 {
+		return false
+	}
+	c := a * b
+	return c/b != a || (a == -1 && b == -1<<63) || (b == -1 && a == -1<<63)
+}
@@ -0,0 +1,115 @@
+package basecrm
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+func TestDecimal(t *testing.T) { TestingT(t) }
+
+type DecimalSuite struct {
+}
+
+var _ = Suite(&DecimalSuite{})
+
+func (s *DecimalSuite) TestParseDecimal(c *C) {
+	cases := map[string]string{
+		"1000.50": "1000.50",
+		"-3":      "-3",
+		"+0.05":   "0.05",
+		".5":      "0.5",
+		"7.":      "7",
+		"1.5e3":   "1500",
+		"25E-4":   "0.0025",
+		"-0.001":  "-0.001",
+
+		"12345678901234567890":  "12345678901234567890",
+		"-9.5e18":               "-9500000000000000000",
+		"1e19":                  "10000000000000000000",
+		"0.1234567890123456789": "0.123456789012345679",
+		"5e-19":                 "0.000000000000000001",
+		"1e-20":                 "0.000000000000000000",
+	}
+	for input, expected := range cases {
+		d, err := ParseDecimal(input)
+		c.Assert(err, IsNil, Commentf("input %s", input))
+		c.Assert(d.String(), Equals, expected, Commentf("input %s", input))
+	}
+
+	for _, input := range []string{"", "-", "abc", "1.2.3", "1e", "1,5", "1e2000"} {
+		_, err := ParseDecimal(input)
+		c.Assert(err, NotNil, Commentf("input %s", input))
+	}
+}
+
+func (s *DecimalSuite) TestArithmetic(c *C) {
+	a := MustParseDecimal("1000.50")
+	b := MustParseDecimal("0.255")
+
+	c.Assert(a.Add(b).String(), Equals, "1000.755")
+	c.Assert(a.Sub(b).String(), Equals, "1000.245")
+	c.Assert(b.Sub(a).String(), Equals, "-1000.245")
+	c.Assert(a.Mul(NewDecimal(3, 0)).String(), Equals, "3001.50")
+	c.Assert(a.Neg().String(), Equals, "-1000.50")
+
+	c.Assert(a.Cmp(b), Equals, 1)
+	c.Assert(b.Cmp(a), Equals, -1)
+	c.Assert(a.Equal(MustParseDecimal("1000.5")), Equals, true)
+	c.Assert(Decimal{}.IsZero(), Equals, true)
+	c.Assert(MustParseDecimal("0.00").IsZero(), Equals, true)
+	c.Assert(a.Float64(), Equals, 1000.5)
+}
+
+func (s *DecimalSuite) TestArithmetic_Overflow(c *C) {
+	large := MustParseDecimal("999999999999999999")
+	cent := MustParseDecimal("0.01")
+
+	c.Assert(large.Cmp(cent), Equals, 1)
+	c.Assert(cent.Cmp(large), Equals, -1)
+	c.Assert(large.Equal(cent), Equals, false)
+	c.Assert(large.Add(cent).String(), Equals, "999999999999999999.01")
+	c.Assert(large.Add(cent).Sub(cent).Equal(large), Equals, true)
+	c.Assert(cent.Sub(large).Sign(), Equals, -1)
+
+	c.Assert(NewDecimal(1<<62, 0).Mul(NewDecimal(4, 0)).String(), Equals, "18446744073709551616")
+	c.Assert(NewDecimal(-1<<63, 0).Neg().String(), Equals, "9223372036854775808")
+
+	// products are rounded to 18 decimal places
+	c.Assert(MustParseDecimal("0.0000000005").Mul(MustParseDecimal("0.000000003")).String(), Equals, "0.000000000000000002")
+	c.Assert(MustParseDecimal("1.000000001").Mul(MustParseDecimal("1.000000001")).String(), Equals, "1.000000002000000001")
+	c.Assert(MustParseDecimal("1.5e-9").Mul(MustParseDecimal("1e-9")).StringFixed(18), Equals, "0.000000000000000002")
+}
+
+func (s *DecimalSuite) TestRound(c *C) {
+	c.Assert(MustParseDecimal("0.255").StringFixed(2), Equals, "0.26")
+	c.Assert(MustParseDecimal("0.254").StringFixed(2), Equals, "0.25")
+	c.Assert(MustParseDecimal("-0.255").StringFixed(2), Equals, "-0.26")
+	c.Assert(MustParseDecimal("1599.5").StringFixed(0), Equals, "1600")
+	c.Assert(MustParseDecimal("1000.5").StringFixed(2), Equals, "1000.50")
+	c.Assert(NewDecimal(42, -2).String(), Equals, "4200")
+	c.Assert(MustParseDecimal("12345678901234567890.5").StringFixed(0), Equals, "12345678901234567891")
+}
+
+func (s *DecimalSuite) TestJSON(c *C) {
+	var values struct {
+		Number Decimal `json:"number"`
+		String Decimal `json:"string"`
+		Null   Decimal `json:"null"`
+		Empty  Decimal `json:"empty"`
+	}
+	err := json.Unmarshal([]byte(`{"number": 1000.50, "string": "3199.98", "null": null, "empty": ""}`), &values)
+	c.Assert(err, IsNil)
+	c.Assert(values.Number.String(), Equals, "1000.50")
+	c.Assert(values.String.String(), Equals, "3199.98")
+	c.Assert(values.Null.IsZero(), Equals, true)
+	c.Assert(values.Empty.IsZero(), Equals, true)
+
+	data, err := json.Marshal(values)
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, `{"number":1000.50,"string":3199.98,"null":0,"empty":0}`)
+
+	err = json.Unmarshal([]byte(`{"number": "12a"}`), &values)
+	c.Assert(err, ErrorMatches, `basecrm: invalid decimal "12a"`)
+}
@@ -1,7 +1,32 @@
 package basecrm
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Sentinel errors matched by API errors with errors.Is, based on the HTTP status code of the response.
+var (
+	// ErrNotFound is matched by errors caused by 404 Not Found responses.
+	ErrNotFound = errors.New("basecrm: resource not found")
+
+	// ErrValidation is matched by errors caused by 422 Unprocessable Entity responses.
+	ErrValidation = errors.New("basecrm: validation failed")
+
+	// ErrUnauthorized is matched by errors caused by 401 Unauthorized responses.
+	ErrUnauthorized = errors.New("basecrm: unauthorized")
+
+	// ErrRateLimited is matched by errors caused by 429 Too Many Requests responses.
+	ErrRateLimited = errors.New("basecrm: rate limit exceeded")
+
+	// ErrConflict is matched by errors caused by 409 Conflict responses.
+	ErrConflict = errors.New("basecrm: conflict")
+
+	// ErrServerError is matched by errors caused by 5xx responses.
+	ErrServerError = errors.New("basecrm: server error")
 )
 
 type ErrorLinks struct {
@@ -49,17 +74,195 @@ type ErrorsEnvelope struct {
 	Meta   *ErrorsMeta      `json:"meta"`
 }
 
+// maxBodySnippet is the maximum length of a non-JSON response body included in error messages.
+const maxBodySnippet = 200
+
+func (err *Error) String() string {
+	if err == nil {
+		return "<nil>"
+	}
+
+	var buf bytes.Buffer
+	fields := []struct{ key, value string }{
+		{"resource", err.Resource},
+		{"field", err.Field},
+		{"code", err.Code},
+		{"message", err.Message},
+		{"details", err.Details},
+	}
+	for _, f := range fields {
+		if f.value == "" {
+			continue
+		}
+		if buf.Len() > 0 {
+			buf.WriteString(", ")
+		}
+		fmt.Fprintf(&buf, "%s=%s", f.key, f.value)
+	}
+	return buf.String()
+}
+
 func (envelope *ErrorsEnvelope) String() string {
-	err := envelope.Errors[0].Error
-	return fmt.Sprintf("resource=%v, field=%v, code=%v, message=%v, details=%v, logref=%v",
-		err.Resource,
-		err.Field,
-		err.Code,
-		err.Message,
-		err.Details,
-		envelope.Meta.Logref)
+	if envelope == nil {
+		return "<nil>"
+	}
+
+	var buf bytes.Buffer
+	envelope.writeErrors(&buf)
+
+	if envelope.Meta != nil && envelope.Meta.Logref != "" {
+		if buf.Len() > 0 {
+			buf.WriteString(", ")
+		}
+		fmt.Fprintf(&buf, "logref=%s", envelope.Meta.Logref)
+	}
+
+	return buf.String()
 }
 
 func (envelope *ErrorsEnvelope) Error() string {
 	return envelope.String()
 }
+
+// writeErrors writes every error of the envelope to buf, separated by semicolons.
+func (envelope *ErrorsEnvelope) writeErrors(buf *bytes.Buffer) {
+	n := 0
+	for _, e := range envelope.Errors {
+		if e == nil || e.Error == nil {
+			continue
+		}
+		if n > 0 {
+			buf.WriteString("; ")
+		}
+		buf.WriteString(e.Error.String())
+		n++
+	}
+}
+
+// writeResponseLine writes the method, URL and status of the response to buf.
+func writeResponseLine(buf *bytes.Buffer, r *http.Response) {
+	if r == nil {
+		buf.WriteString("<nil response>")
+		return
+	}
+
+	if r.Request != nil {
+		fmt.Fprintf(buf, "%v %v: ", r.Request.Method, r.Request.URL)
+	}
+
+	if r.Status != "" {
+		buf.WriteString(r.Status)
+	} else {
+		fmt.Fprintf(buf, "%d %s", r.StatusCode, http.StatusText(r.StatusCode))
+	}
+}
+
+// bodySnippet returns a single line, truncated excerpt of a response body.
+func bodySnippet(body []byte) string {
+	s := strings.Join(strings.Fields(string(body)), " ")
+	if len(s) > maxBodySnippet {
+		s = s[:maxBodySnippet] + "..."
+	}
+	return s
+}
+
+// IsNotFound reports whether err was caused by a 404 Not Found response.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsValidation reports whether err was caused by a 422 Unprocessable Entity response.
+// Use errors.As with a *ValidationError to inspect the individual field errors.
+func IsValidation(err error) bool {
+	return errors.Is(err, ErrValidation)
+}
+
+// IsUnauthorized reports whether err was caused by a 401 Unauthorized response.
+func IsUnauthorized(err error) bool {
+	return errors.Is(err, ErrUnauthorized)
+}
+
+// IsRateLimited reports whether err was caused by a 429 Too Many Requests response.
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+// IsConflict reports whether err was caused by a 409 Conflict response.
+func IsConflict(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
+
+// IsServerError reports whether err was caused by a 5xx response.
+func IsServerError(err error) bool {
+	return errors.Is(err, ErrServerError)
+}
+
+// statusError returns the sentinel error matching the HTTP status code, or nil.
+func statusError(code int) error {
+	switch {
+	case code == http.StatusNotFound:
+		return ErrNotFound
+	case code == http.StatusUnprocessableEntity:
+		return ErrValidation
+	case code == http.StatusUnauthorized:
+		return ErrUnauthorized
+	case code == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case code == http.StatusConflict:
+		return ErrConflict
+	case code >= 500 && code <= 599:
+		return ErrServerError
+	}
+	return nil
+}
+
+// Is reports whether the response status code matches the target sentinel error.
+func (r *ErrorResponse) Is(target error) bool {
+	if r.Response == nil {
+		return false
+	}
+	return target != nil && statusError(r.Response.StatusCode) == target
+}
+
+// Is reports whether target is ErrRateLimited.
+func (r *RateLimitError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+// ValidationError is returned when the API rejects a request with 422 Unprocessable Entity.
+// Unlike ErrorResponse it exposes every field-level error from the envelope.
+type ValidationError struct {
+	// The underlying API error.
+	*ErrorResponse
+
+	// Every field-level error reported by the API, in the order they were sent.
+	Fields []*Error
+}
+
+func newValidationError(r *ErrorResponse) *ValidationError {
+	v := &ValidationError{ErrorResponse: r}
+	if r.Errors != nil {
+		for _, envelope := range r.Errors.Errors {
+			if envelope != nil && envelope.Error != nil {
+				v.Fields = append(v.Fields, envelope.Error)
+			}
+		}
+	}
+	return v
+}
+
+// Field returns the errors reported for the given field of the resource.
+func (v *ValidationError) Field(name string) []*Error {
+	var errs []*Error
+	for _, err := range v.Fields {
+		if err.Field == name {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// Unwrap returns the underlying ErrorResponse.
+func (v *ValidationError) Unwrap() error {
+	return v.ErrorResponse
+}
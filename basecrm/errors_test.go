@@ -0,0 +1,212 @@
+package basecrm
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+func TestErrors(t *testing.T) { TestingT(t) }
+
+type ErrorsSuite struct {
+}
+
+var _ = Suite(&ErrorsSuite{})
+
+func (s *ErrorsSuite) TestErrors_StatusPredicates(c *C) {
+	setup()
+	defer teardown()
+
+	statuses := map[string]int{
+		"/v2/deals/404": http.StatusNotFound,
+		"/v2/deals/401": http.StatusUnauthorized,
+		"/v2/deals/409": http.StatusConflict,
+		"/v2/deals/429": http.StatusTooManyRequests,
+		"/v2/deals/500": http.StatusInternalServerError,
+		"/v2/deals/503": http.StatusServiceUnavailable,
+		"/v2/deals/400": http.StatusBadRequest,
+	}
+	for path, status := range statuses {
+		status := status
+		mux.HandleFunc(path, func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(status)
+		})
+	}
+
+	_, _, err := client.Deals.Get(404)
+	c.Assert(IsNotFound(err), Equals, true)
+	c.Assert(errors.Is(err, ErrNotFound), Equals, true)
+	c.Assert(IsServerError(err), Equals, false)
+
+	_, _, err = client.Deals.Get(401)
+	c.Assert(IsUnauthorized(err), Equals, true)
+
+	_, _, err = client.Deals.Get(409)
+	c.Assert(IsConflict(err), Equals, true)
+
+	_, _, err = client.Deals.Get(429)
+	c.Assert(IsRateLimited(err), Equals, true)
+
+	_, _, err = client.Deals.Get(500)
+	c.Assert(IsServerError(err), Equals, true)
+
+	_, _, err = client.Deals.Get(503)
+	c.Assert(IsServerError(err), Equals, true)
+
+	_, _, err = client.Deals.Get(400)
+	c.Assert(err, NotNil)
+	c.Assert(IsNotFound(err), Equals, false)
+	c.Assert(IsValidation(err), Equals, false)
+	c.Assert(IsServerError(err), Equals, false)
+
+	wrapped := fmt.Errorf("sync failed: %w", err)
+	var errorResponse *ErrorResponse
+	c.Assert(errors.As(wrapped, &errorResponse), Equals, true)
+	c.Assert(errorResponse.Response.StatusCode, Equals, http.StatusBadRequest)
+}
+
+func (s *ErrorsSuite) TestErrors_Validation(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/contacts", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+
+		jsonBlob := `
+    {
+      "errors": [{
+        "error": {
+          "resource": "contact",
+          "field": "last_name",
+          "code": "blank",
+          "message": "can't be blank"
+        }
+      }, {
+        "error": {
+          "resource": "contact",
+          "field": "email",
+          "code": "invalid",
+          "message": "is invalid"
+        }
+      }],
+      "meta": {
+        "type": "errors",
+        "http_status": "422 Unprocessable Entity",
+        "logref": "ab12"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	_, _, err := client.Contacts.Create(&Contact{Email: "mark"})
+	c.Assert(err, NotNil)
+	c.Assert(IsValidation(fmt.Errorf("create: %w", err)), Equals, true)
+
+	var validationErr *ValidationError
+	c.Assert(errors.As(err, &validationErr), Equals, true)
+	c.Assert(len(validationErr.Fields), Equals, 2)
+	c.Assert(validationErr.Fields[0].Field, Equals, "last_name")
+	c.Assert(validationErr.Fields[1].Field, Equals, "email")
+	c.Assert(validationErr.Field("email")[0].Code, Equals, "invalid")
+	c.Assert(validationErr.Field("name"), HasLen, 0)
+
+	var errorResponse *ErrorResponse
+	c.Assert(errors.As(err, &errorResponse), Equals, true)
+	c.Assert(errorResponse.Errors.Meta.Logref, Equals, "ab12")
+}
+
+func (s *ErrorsSuite) TestErrorResponse_NonJSONBody(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/deals/1", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Add("Content-Type", "text/html")
+		w.Header().Add("X-Request-Id", "req-1")
+		w.WriteHeader(http.StatusBadGateway)
+		fmt.Fprintf(w, "<html>\n  <body>502 Bad Gateway</body>\n</html>\n")
+	})
+
+	_, _, err := client.Deals.Get(1)
+	c.Assert(err, NotNil)
+
+	errorResponse := err.(*ErrorResponse)
+	c.Assert(errorResponse.Errors, IsNil)
+	c.Assert(string(errorResponse.Body), Equals, "<html>\n  <body>502 Bad Gateway</body>\n</html>\n")
+	c.Assert(errorResponse.RequestId, Equals, "req-1")
+	c.Assert(err, ErrorMatches, `GET http://.*/v2/deals/1: 502 Bad Gateway <html> <body>502 Bad Gateway</body> </html> \(request_id=req-1\)`)
+}
+
+func (s *ErrorsSuite) TestErrorResponse_EmptyBody(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/deals/1", func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, _, err := client.Deals.Get(1)
+	c.Assert(err, ErrorMatches, `GET http://.*/v2/deals/1: 404 Not Found`)
+}
+
+func (s *ErrorsSuite) TestErrorResponse_MultipleErrors(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/deals", func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+
+		jsonBlob := `
+    {
+      "errors": [{
+        "error": {
+          "resource": "deal",
+          "field": "name",
+          "code": "blank",
+          "message": "can't be blank"
+        }
+      }, {
+        "error": {
+          "resource": "deal",
+          "field": "value",
+          "code": "not_a_number",
+          "message": "is not a number"
+        }
+      }],
+      "meta": {
+        "type": "errors",
+        "http_status": "400 Bad Request",
+        "logref": "ab12"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	_, _, err := client.Deals.Create(&Deal{})
+	c.Assert(err, NotNil)
+
+	errorResponse := err.(*ErrorResponse)
+	c.Assert(errorResponse.RequestId, Equals, "ab12")
+	c.Assert(errorResponse.Errors.String(), Equals,
+		"resource=deal, field=name, code=blank, message=can't be blank; "+
+			"resource=deal, field=value, code=not_a_number, message=is not a number, logref=ab12")
+	c.Assert(err, ErrorMatches, `POST http://.*/v2/deals: 400 Bad Request `+
+		`resource=deal, field=name, code=blank, message=can't be blank; `+
+		`resource=deal, field=value, code=not_a_number, message=is not a number \(request_id=ab12\)`)
+}
+
+func (s *ErrorsSuite) TestErrorResponse_NeverPanics(c *C) {
+	c.Assert((&ErrorResponse{}).Error(), Equals, "<nil response>")
+	c.Assert((&ErrorResponse{Response: &http.Response{StatusCode: 500}}).Error(), Equals, "500 Internal Server Error")
+	c.Assert((&RateLimitError{}).Error(), Equals, "<nil response> API rate limit exceeded")
+
+	var envelope *ErrorsEnvelope
+	c.Assert(envelope.String(), Equals, "<nil>")
+	c.Assert((&ErrorsEnvelope{}).String(), Equals, "")
+	c.Assert((&ErrorsEnvelope{Errors: []*ErrorEnvelope{nil, {}}}).String(), Equals, "")
+}
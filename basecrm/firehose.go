@@ -0,0 +1,312 @@
+package basecrm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// FirehoseTail is the stream position which starts consuming at the most recent events.
+const FirehoseTail = "tail"
+
+const (
+	defaultFirehosePollInterval = 5 * time.Second
+	defaultFirehoseBufferSize   = 100
+	defaultFirehoseMaxAttempts  = 10
+)
+
+// FirehoseEvent is a single change read from a resource event stream.
+type FirehoseEvent struct {
+	// Type of the resource, e.g. deal, contact or lead.
+	Type string `json:"type"`
+	// One of SyncCreated, SyncUpdated or SyncDeleted.
+	EventType string    `json:"event_type"`
+	EventId   string    `json:"event_id"`
+	EventTime Timestamp `json:"event_time"`
+	Sequence  int       `json:"sequence"`
+	// Resource holds the decoded model, e.g. *Deal or *Contact,
+	// or a map[string]interface{} for unknown types. Nil when the event carries no data.
+	Resource interface{}     `json:"-"`
+	Data     json.RawMessage `json:"-"`
+}
+
+// FirehosePage is a batch of events read from a resource event stream.
+type FirehosePage struct {
+	Events []*FirehoseEvent
+	// Position to pass to the next Stream call to read the following events.
+	Position string
+	// Top is set when the stream has no more events at the moment.
+	Top bool
+}
+
+type FirehoseService interface {
+	Stream(resource, position string) (*FirehosePage, *Response, error)
+	StreamContext(ctx context.Context, resource, position string) (*FirehosePage, *Response, error)
+}
+
+func NewFirehoseService(client *Client) FirehoseService {
+	return &FirehoseServiceOp{client}
+}
+
+type firehoseRoot struct {
+	Items []*struct {
+		Data  json.RawMessage `json:"data"`
+		Event *FirehoseEvent  `json:"meta"`
+	} `json:"items"`
+	Meta *struct {
+		Position string `json:"position"`
+		Top      bool   `json:"top"`
+	} `json:"meta"`
+}
+
+type firehoseStreamOptions struct {
+	Position string `url:"position,omitempty"`
+}
+
+type FirehoseServiceOp struct {
+	client *Client
+}
+
+// Stream reads the next batch of events of a resource, e.g. deals or contacts,
+// starting at the position. Use FirehoseTail to start at the most recent events.
+func (s *FirehoseServiceOp) Stream(resource, position string) (*FirehosePage, *Response, error) {
+	return s.StreamContext(context.Background(), resource, position)
+}
+
+func (s *FirehoseServiceOp) StreamContext(ctx context.Context, resource, position string) (*FirehosePage, *Response, error) {
+	u := fmt.Sprintf("/v3/%s/stream", resource)
+	u, err := addOptions(u, &firehoseStreamOptions{position})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(firehoseRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	page := &FirehosePage{Position: position, Top: true}
+	if root.Meta != nil {
+		page.Position = root.Meta.Position
+		page.Top = root.Meta.Top
+	}
+
+	for _, item := range root.Items {
+		if item == nil || item.Event == nil {
+			continue
+		}
+
+		event := item.Event
+		event.Resource, err = DecodeResource(event.Type, item.Data)
+		if err != nil {
+			return nil, res, err
+		}
+		event.Data = item.Data
+
+		page.Events = append(page.Events, event)
+	}
+
+	return page, res, nil
+}
+
+// FirehoseOptions configures a Firehose consumer.
+type FirehoseOptions struct {
+	// Resources to consume, e.g. deals, contacts and leads.
+	Resources []string
+	// Positions to resume from, keyed by resource, as returned by Firehose.Positions.
+	// Resources without a position start at FirehoseTail.
+	Positions map[string]string
+	// How long to wait before polling a stream which has no more events.
+	// Defaults to 5 seconds.
+	PollInterval time.Duration
+	// Capacity of the events channel. Consumers which fall behind block reading
+	// from the streams once the buffer is full. Defaults to 100.
+	BufferSize int
+	// Policy used to retry a stream whose request failed with a 5xx, a 429 or a
+	// transient network error. The stream resumes from its last position, and the
+	// consumer stops once MaxAttempts consecutive requests failed. Defaults to
+	// DefaultRetryPolicy with 10 attempts. It replaces the client's RetryPolicy,
+	// which is not applied to the stream requests of a consumer.
+	RetryPolicy *RetryPolicy
+}
+
+// Firehose consumes the event streams of several resources and delivers their events over a channel.
+type Firehose struct {
+	client *Client
+	opt    FirehoseOptions
+
+	events chan *FirehoseEvent
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu        sync.Mutex
+	positions map[string]string
+	err       error
+}
+
+// NewFirehose returns a consumer of the resource event streams. Call Start to begin consuming.
+func NewFirehose(client *Client, opt *FirehoseOptions) *Firehose {
+	f := &Firehose{client: client, positions: map[string]string{}}
+	if opt != nil {
+		f.opt = *opt
+	}
+	if f.opt.PollInterval <= 0 {
+		f.opt.PollInterval = defaultFirehosePollInterval
+	}
+	if f.opt.BufferSize <= 0 {
+		f.opt.BufferSize = defaultFirehoseBufferSize
+	}
+	if f.opt.RetryPolicy == nil {
+		f.opt.RetryPolicy = DefaultRetryPolicy()
+		f.opt.RetryPolicy.MaxAttempts = defaultFirehoseMaxAttempts
+	}
+	for _, resource := range f.opt.Resources {
+		f.positions[resource] = FirehoseTail
+		if position, ok := f.opt.Positions[resource]; ok && position != "" {
+			f.positions[resource] = position
+		}
+	}
+	f.events = make(chan *FirehoseEvent, f.opt.BufferSize)
+	return f
+}
+
+// Start starts reading the streams in the background. Reading stops when ctx is done,
+// Close is called or a request fails for good; the events channel is closed afterwards.
+func (f *Firehose) Start(ctx context.Context) error {
+	if len(f.opt.Resources) == 0 {
+		return errors.New("basecrm: firehose requires at least one resource")
+	}
+	if f.cancel != nil {
+		return errors.New("basecrm: firehose already started")
+	}
+
+	ctx, f.cancel = context.WithCancel(ctx)
+	for _, resource := range f.opt.Resources {
+		f.wg.Add(1)
+		go f.consume(ctx, resource)
+	}
+
+	go func() {
+		f.wg.Wait()
+		close(f.events)
+	}()
+
+	return nil
+}
+
+// Events returns the channel the events are delivered on.
+func (f *Firehose) Events() <-chan *FirehoseEvent {
+	return f.events
+}
+
+// Positions returns the stream positions to resume from, keyed by resource.
+// A position advances only once all events of its batch were delivered on the channel,
+// so resuming may deliver some events again.
+func (f *Firehose) Positions() map[string]string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	positions := make(map[string]string, len(f.positions))
+	for resource, position := range f.positions {
+		positions[resource] = position
+	}
+	return positions
+}
+
+// Err returns the error which stopped the consumer, if any.
+func (f *Firehose) Err() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.err
+}
+
+// Close stops the consumer and waits for it to shut down. Events not read yet
+// remain in the channel. It returns the error which stopped the consumer, if any.
+func (f *Firehose) Close() error {
+	if f.cancel != nil {
+		f.cancel()
+	}
+	f.wg.Wait()
+	return f.Err()
+}
+
+func (f *Firehose) consume(ctx context.Context, resource string) {
+	defer f.wg.Done()
+
+	// failed requests are retried here, from the last position, so the client must not retry them too
+	streamCtx := withoutRetries(ctx)
+
+	position := f.Positions()[resource]
+	failures := 0
+	for {
+		page, _, err := f.client.Firehose.StreamContext(streamCtx, resource, position)
+		if err != nil {
+			failures++
+			if ctx.Err() != nil || !f.retryable(err) || failures >= f.opt.RetryPolicy.MaxAttempts {
+				f.fail(ctx, err)
+				return
+			}
+			if err := sleep(ctx, f.opt.RetryPolicy.backoff(failures)); err != nil {
+				return
+			}
+			continue
+		}
+		failures = 0
+
+		for _, event := range page.Events {
+			select {
+			case f.events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		position = page.Position
+		f.mu.Lock()
+		f.positions[resource] = position
+		f.mu.Unlock()
+
+		if page.Top || len(page.Events) == 0 {
+			if err := sleep(ctx, f.opt.PollInterval); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// retryable reports whether a stream request which failed with err may succeed
+// when retried.
+func (f *Firehose) retryable(err error) bool {
+	if IsServerError(err) || IsRateLimited(err) {
+		return true
+	}
+	if f.opt.RetryPolicy.RetryableError != nil {
+		return f.opt.RetryPolicy.RetryableError(err)
+	}
+	return IsRetryableError(err)
+}
+
+// fail records the first error and stops the other streams. Errors caused
+// by shutting down are not recorded.
+func (f *Firehose) fail(ctx context.Context, err error) {
+	if ctx.Err() != nil {
+		return
+	}
+
+	f.mu.Lock()
+	if f.err == nil {
+		f.err = fmt.Errorf("basecrm: firehose: %w", err)
+	}
+	f.mu.Unlock()
+
+	f.cancel()
+}
@@ -0,0 +1,210 @@
+package basecrm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func TestFirehoseService(t *testing.T) { TestingT(t) }
+
+type FirehoseSuite struct {
+}
+
+var _ = Suite(&FirehoseSuite{})
+
+func (s *FirehoseSuite) TestFirehoseService_Stream(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v3/deals/stream", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req, HasHttpMethod, "GET")
+		c.Assert(req, HasQueryParams, map[string]string{"position": "tail"})
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "items": [{
+        "data": {
+          "id": 1,
+          "name": "Website Redesign"
+        },
+        "meta": {
+          "type": "deal",
+          "event_type": "updated",
+          "event_id": "a2b0c3f6-6fb1-4ae4-96bd-5d6b2b3c1f6a",
+          "event_time": "2014-08-27T16:32:56Z",
+          "sequence": 42
+        }
+      }],
+      "meta": {
+        "position": "92d7d3bb-ab55-4e51-9cc3-a9c4b40b1ae0",
+        "top": false
+      }
+    }
+    `
+		fmt.Fprint(w, jsonBlob)
+	})
+
+	page, _, err := client.Firehose.Stream("deals", FirehoseTail)
+	c.Assert(err, IsNil)
+	c.Assert(page.Position, Equals, "92d7d3bb-ab55-4e51-9cc3-a9c4b40b1ae0")
+	c.Assert(page.Top, Equals, false)
+	c.Assert(page.Events, HasLen, 1)
+
+	event := page.Events[0]
+	c.Assert(event.Type, Equals, "deal")
+	c.Assert(event.EventType, Equals, SyncUpdated)
+	c.Assert(event.EventId, Equals, "a2b0c3f6-6fb1-4ae4-96bd-5d6b2b3c1f6a")
+	c.Assert(event.EventTime.Time, Equals, time.Date(2014, 8, 27, 16, 32, 56, 0, time.UTC))
+	c.Assert(event.Sequence, Equals, 42)
+
+	deal, ok := event.Resource.(*Deal)
+	c.Assert(ok, Equals, true)
+	c.Assert(deal.Name, Equals, "Website Redesign")
+}
+
+func (s *FirehoseSuite) TestFirehose_Consume(c *C) {
+	setup()
+	defer teardown()
+
+	pages := map[string]string{
+		"p0": `{"items": [
+      {"data": {"id": 1}, "meta": {"type": "contact", "event_type": "created", "event_id": "e1"}},
+      {"data": {"id": 2}, "meta": {"type": "contact", "event_type": "created", "event_id": "e2"}}],
+      "meta": {"position": "p1", "top": false}}`,
+		"p1": `{"items": [
+      {"data": {"id": 1}, "meta": {"type": "contact", "event_type": "updated", "event_id": "e3"}}],
+      "meta": {"position": "p2", "top": true}}`,
+	}
+	mux.HandleFunc("/v3/contacts/stream", func(w http.ResponseWriter, req *http.Request) {
+		position := req.URL.Query().Get("position")
+		if blob, ok := pages[position]; ok {
+			fmt.Fprint(w, blob)
+			return
+		}
+		fmt.Fprintf(w, `{"items": [], "meta": {"position": %q, "top": true}}`, position)
+	})
+
+	f := NewFirehose(client, &FirehoseOptions{
+		Resources:    []string{"contacts"},
+		Positions:    map[string]string{"contacts": "p0"},
+		PollInterval: time.Millisecond,
+		BufferSize:   1,
+	})
+	c.Assert(f.Start(context.Background()), IsNil)
+
+	var ids []string
+	for event := range f.Events() {
+		c.Assert(event.Resource, FitsTypeOf, &Contact{})
+		ids = append(ids, event.EventId)
+		if len(ids) == 3 {
+			break
+		}
+	}
+	c.Assert(ids, DeepEquals, []string{"e1", "e2", "e3"})
+
+	c.Assert(f.Close(), IsNil)
+	c.Assert(f.Positions(), DeepEquals, map[string]string{"contacts": "p2"})
+
+	_, open := <-f.Events()
+	c.Assert(open, Equals, false)
+}
+
+func (s *FirehoseSuite) TestFirehose_Error(c *C) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/v3/leads/stream", func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	f := NewFirehose(client, &FirehoseOptions{Resources: []string{"leads"}})
+	c.Assert(f.Start(context.Background()), IsNil)
+
+	for range f.Events() {
+		c.Fatal("unexpected event")
+	}
+	c.Assert(IsUnauthorized(f.Err()), Equals, true)
+	c.Assert(IsUnauthorized(f.Close()), Equals, true)
+	c.Assert(requests, Equals, 1)
+	c.Assert(f.Positions(), DeepEquals, map[string]string{"leads": FirehoseTail})
+}
+
+func (s *FirehoseSuite) TestFirehose_Retry(c *C) {
+	setup()
+	defer teardown()
+
+	var positions []string
+	mux.HandleFunc("/v3/deals/stream", func(w http.ResponseWriter, req *http.Request) {
+		position := req.URL.Query().Get("position")
+		positions = append(positions, position)
+		switch {
+		case position == "p0":
+			fmt.Fprint(w, `{"items": [{"data": {"id": 1}, "meta": {"type": "deal", "event_type": "created", "event_id": "e1"}}],
+      "meta": {"position": "p1", "top": false}}`)
+		case len(positions) < 4:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			fmt.Fprint(w, `{"items": [{"data": {"id": 1}, "meta": {"type": "deal", "event_type": "updated", "event_id": "e2"}}],
+      "meta": {"position": "p2", "top": true}}`)
+		}
+	})
+
+	f := NewFirehose(client, &FirehoseOptions{
+		Resources:    []string{"deals"},
+		Positions:    map[string]string{"deals": "p0"},
+		PollInterval: time.Hour,
+		RetryPolicy:  &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond},
+	})
+	c.Assert(f.Start(context.Background()), IsNil)
+
+	var ids []string
+	for event := range f.Events() {
+		ids = append(ids, event.EventId)
+		if len(ids) == 2 {
+			break
+		}
+	}
+	c.Assert(f.Close(), IsNil)
+	c.Assert(ids, DeepEquals, []string{"e1", "e2"})
+	// failed requests are retried from the last position
+	c.Assert(positions, DeepEquals, []string{"p0", "p1", "p1", "p1"})
+}
+
+func (s *FirehoseSuite) TestFirehose_RetriesExhausted(c *C) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/v3/leads/stream", func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	// the consumer's policy replaces the client's one rather than multiplying it
+	client.RetryPolicy = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+
+	f := NewFirehose(client, &FirehoseOptions{
+		Resources:   []string{"leads"},
+		RetryPolicy: &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond},
+	})
+	c.Assert(f.Start(context.Background()), IsNil)
+
+	for range f.Events() {
+		c.Fatal("unexpected event")
+	}
+	c.Assert(IsServerError(f.Close()), Equals, true)
+	c.Assert(requests, Equals, 3)
+}
+
+func (s *FirehoseSuite) TestFirehose_NoResources(c *C) {
+	f := NewFirehose(client, nil)
+	c.Assert(f.Start(context.Background()), ErrorMatches, ".*at least one resource")
+}
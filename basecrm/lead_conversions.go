@@ -0,0 +1,129 @@
+package basecrm
+
+import (
+	"context"
+)
+
+// LeadConversion links a converted lead with the records created from it.
+type LeadConversion struct {
+	Id             int       `json:"id,omitempty"`
+	LeadId         int       `json:"lead_id,omitempty"`
+	IndividualId   int       `json:"individual_id,omitempty"`   // id of the contact created for the person
+	OrganizationId int       `json:"organization_id,omitempty"` // id of the contact created for the organization, if any
+	DealId         int       `json:"deal_id,omitempty"`
+	CreatorId      int       `json:"creator_id,omitempty"`
+	CreatedAt      Timestamp `json:"created_at,omitempty"`
+}
+
+// MarshalJSON encodes the LeadConversion, omitting its unset timestamps.
+func (l LeadConversion) MarshalJSON() ([]byte, error) {
+	type leadConversion LeadConversion
+	return marshalFields(leadConversion(l), nil, nil)
+}
+
+type LeadConversionListOptions struct {
+	LeadId    int `url:"lead_id,omitempty"`
+	CreatorId int `url:"creator_id,omitempty"`
+
+	ListOptions
+}
+
+type LeadConversionsService interface {
+	List(opt *LeadConversionListOptions) ([]*LeadConversion, *Response, error)
+	ListContext(ctx context.Context, opt *LeadConversionListOptions) ([]*LeadConversion, *Response, error)
+	ListAll(opt *LeadConversionListOptions) *Iter[*LeadConversion]
+	ListAllContext(ctx context.Context, opt *LeadConversionListOptions) *Iter[*LeadConversion]
+	Convert(leadId int) (*LeadConversion, *Response, error)
+	ConvertContext(ctx context.Context, leadId int) (*LeadConversion, *Response, error)
+}
+
+func NewLeadConversionsService(client *Client) LeadConversionsService {
+	return &LeadConversionsServiceOp{client}
+}
+
+type leadConversionRoot struct {
+	LeadConversion *LeadConversion `json:"data"`
+	Meta           *struct {
+		Type string `json:"type,omitempty"`
+	} `json:"meta,omitempty"`
+}
+
+type leadConversionsRoot struct {
+	Items []*leadConversionRoot `json:"items"`
+	Meta  *Meta                 `json:"meta"`
+}
+
+func (r *leadConversionsRoot) LeadConversions() []*LeadConversion {
+	leadConversions := make([]*LeadConversion, len(r.Items))
+	for i, root := range r.Items {
+		leadConversions[i] = root.LeadConversion
+	}
+	return leadConversions
+}
+
+type LeadConversionsServiceOp struct {
+	client *Client
+}
+
+func (s *LeadConversionsServiceOp) List(opt *LeadConversionListOptions) ([]*LeadConversion, *Response, error) {
+	return s.ListContext(context.Background(), opt)
+}
+
+func (s *LeadConversionsServiceOp) ListContext(ctx context.Context, opt *LeadConversionListOptions) ([]*LeadConversion, *Response, error) {
+	u, err := addOptions("/v2/lead_conversions", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(leadConversionsRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	res.setMeta(root.Meta)
+
+	return root.LeadConversions(), res, err
+}
+
+func (s *LeadConversionsServiceOp) ListAll(opt *LeadConversionListOptions) *Iter[*LeadConversion] {
+	return s.ListAllContext(context.Background(), opt)
+}
+
+func (s *LeadConversionsServiceOp) ListAllContext(ctx context.Context, opt *LeadConversionListOptions) *Iter[*LeadConversion] {
+	o := LeadConversionListOptions{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIter(ctx, o.Page, func(ctx context.Context, page int) ([]*LeadConversion, *Response, error) {
+		o.Page = page
+		return s.ListContext(ctx, &o)
+	})
+}
+
+func (s *LeadConversionsServiceOp) Convert(leadId int) (*LeadConversion, *Response, error) {
+	return s.ConvertContext(context.Background(), leadId)
+}
+
+func (s *LeadConversionsServiceOp) ConvertContext(ctx context.Context, leadId int) (*LeadConversion, *Response, error) {
+	u := "/v2/lead_conversions"
+	envelope := &leadConversionRoot{LeadConversion: &LeadConversion{LeadId: leadId}}
+	req, err := s.client.NewRequestContext(ctx, "POST", u, envelope)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(leadConversionRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return root.LeadConversion, res, err
+}
@@ -0,0 +1,134 @@
+package basecrm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func TestLeadConversionsService(t *testing.T) { TestingT(t) }
+
+type LeadConversionsSuite struct {
+}
+
+var _ = Suite(&LeadConversionsSuite{})
+
+func (s *LeadConversionsSuite) TestLeadConversionsService_List_All(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/lead_conversions", func(w http.ResponseWriter, req *http.Request) {
+		expected := map[string]string{
+			"lead_id":    "1",
+			"creator_id": "1",
+			"page":       "1",
+			"per_page":   "25",
+			"ids":        "1,2,3",
+			"sort_by":    "name:desc,created_at:asc",
+		}
+		c.Assert(req, HasHttpMethod, "GET")
+		c.Assert(req, HasHttpHeader, "Accept", "application/json")
+		c.Assert(req, HasQueryParams, expected)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+     {
+      "items": [{
+          "data": {
+            "id": 1
+          },
+          "meta": {
+            "type": "lead_conversion"
+          }
+      }, {
+          "data": {
+            "id": 2
+          },
+          "meta": {
+            "type": "lead_conversion"
+          }
+      }],
+      "meta": {
+        "type": "collection",
+        "count": 2,
+        "links": {
+          "self": "http://api.getbase.com/v2/lead_conversions.json"
+        }
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	opt := &LeadConversionListOptions{
+		LeadId:    1,
+		CreatorId: 1,
+		ListOptions: ListOptions{
+			Page:    1,
+			PerPage: 25,
+			Ids:     []int{1, 2, 3},
+			SortBy:  []string{"name:desc", "created_at:asc"},
+		},
+	}
+	leadConversions, res, err := client.LeadConversions.List(opt)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(leadConversions, NotNil)
+
+	c.Assert(len(leadConversions), Equals, 2)
+	c.Assert(leadConversions[0].Id, Equals, 1)
+	c.Assert(leadConversions[1].Id, Equals, 2)
+}
+
+func (s *LeadConversionsSuite) TestLeadConversionsService_Convert(c *C) {
+	setup()
+	defer teardown()
+
+	expected := &LeadConversion{
+		Id:             1,
+		LeadId:         2,
+		IndividualId:   3,
+		OrganizationId: 4,
+		DealId:         5,
+		CreatorId:      6,
+		CreatedAt:      NewTimestamp(time.Date(2014, time.September, 28, 16, 32, 56, 0, time.UTC)),
+	}
+
+	mux.HandleFunc("/v2/lead_conversions", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "POST")
+
+		root := new(leadConversionRoot)
+		json.NewDecoder(req.Body).Decode(root)
+		c.Assert(root.LeadConversion, DeepEquals, &LeadConversion{LeadId: 2})
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "lead_id": 2,
+        "individual_id": 3,
+        "organization_id": 4,
+        "deal_id": 5,
+        "creator_id": 6,
+        "created_at": "2014-09-28T16:32:56Z"
+      },
+      "meta": {
+        "type": "lead_conversion"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	conversion, res, err := client.LeadConversions.Convert(2)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(conversion, DeepEquals, expected)
+}
@@ -0,0 +1,201 @@
+package basecrm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type LeadSource struct {
+	Id               int          `json:"id,omitempty"`
+	CreatorId        int          `json:"creator_id,omitempty"`
+	Name             string       `json:"name,omitempty"`
+	ResourceType     ResourceType `json:"resource_type,omitempty"`
+	MarketingChannel string       `json:"marketing_channel,omitempty"`
+	UpdatedAt        Timestamp    `json:"updated_at,omitempty"`
+	CreatedAt        Timestamp    `json:"created_at,omitempty"`
+
+	ForceSendFields []string `json:"-"`
+	NullFields      []string `json:"-"`
+}
+
+// MarshalJSON encodes the LeadSource, sending the fields listed in ForceSendFields and NullFields.
+func (l LeadSource) MarshalJSON() ([]byte, error) {
+	type leadSource LeadSource
+	return marshalFields(leadSource(l), l.ForceSendFields, l.NullFields)
+}
+
+type LeadSourceListOptions struct {
+	CreatorId int    `url:"creator_id,omitempty"`
+	Name      string `url:"name,omitempty"`
+
+	MarketingChannel string `url:"marketing_channel,omitempty"`
+
+	ListOptions
+}
+
+type LeadSourcesService interface {
+	List(opt *LeadSourceListOptions) ([]*LeadSource, *Response, error)
+	ListContext(ctx context.Context, opt *LeadSourceListOptions) ([]*LeadSource, *Response, error)
+	ListAll(opt *LeadSourceListOptions) *Iter[*LeadSource]
+	ListAllContext(ctx context.Context, opt *LeadSourceListOptions) *Iter[*LeadSource]
+	Get(id int) (*LeadSource, *Response, error)
+	GetContext(ctx context.Context, id int) (*LeadSource, *Response, error)
+	Create(leadSource *LeadSource) (*LeadSource, *Response, error)
+	CreateContext(ctx context.Context, leadSource *LeadSource) (*LeadSource, *Response, error)
+	Edit(id int, leadSource *LeadSource) (*LeadSource, *Response, error)
+	EditContext(ctx context.Context, id int, leadSource *LeadSource) (*LeadSource, *Response, error)
+	Delete(id int) (bool, *Response, error)
+	DeleteContext(ctx context.Context, id int) (bool, *Response, error)
+}
+
+func NewLeadSourcesService(client *Client) LeadSourcesService {
+	return &LeadSourcesServiceOp{client}
+}
+
+type leadSourceRoot struct {
+	LeadSource *LeadSource `json:"data"`
+	Meta       *struct {
+		Type string `json:"type,omitempty"`
+	} `json:"meta,omitempty"`
+}
+
+type leadSourcesRoot struct {
+	Items []*leadSourceRoot `json:"items"`
+	Meta  *Meta             `json:"meta"`
+}
+
+func (r *leadSourcesRoot) LeadSources() []*LeadSource {
+	leadSources := make([]*LeadSource, len(r.Items))
+	for i, root := range r.Items {
+		leadSources[i] = root.LeadSource
+	}
+	return leadSources
+}
+
+type LeadSourcesServiceOp struct {
+	client *Client
+}
+
+func (s *LeadSourcesServiceOp) List(opt *LeadSourceListOptions) ([]*LeadSource, *Response, error) {
+	return s.ListContext(context.Background(), opt)
+}
+
+func (s *LeadSourcesServiceOp) ListContext(ctx context.Context, opt *LeadSourceListOptions) ([]*LeadSource, *Response, error) {
+	u, err := addOptions("/v2/lead_sources", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(leadSourcesRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	res.setMeta(root.Meta)
+
+	return root.LeadSources(), res, err
+}
+
+func (s *LeadSourcesServiceOp) ListAll(opt *LeadSourceListOptions) *Iter[*LeadSource] {
+	return s.ListAllContext(context.Background(), opt)
+}
+
+func (s *LeadSourcesServiceOp) ListAllContext(ctx context.Context, opt *LeadSourceListOptions) *Iter[*LeadSource] {
+	o := LeadSourceListOptions{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIter(ctx, o.Page, func(ctx context.Context, page int) ([]*LeadSource, *Response, error) {
+		o.Page = page
+		return s.ListContext(ctx, &o)
+	})
+}
+
+func (s *LeadSourcesServiceOp) Get(id int) (*LeadSource, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+func (s *LeadSourcesServiceOp) GetContext(ctx context.Context, id int) (*LeadSource, *Response, error) {
+	u := fmt.Sprintf("/v2/lead_sources/%d", id)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(leadSourceRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return root.LeadSource, res, err
+}
+
+func (s *LeadSourcesServiceOp) Create(leadSource *LeadSource) (*LeadSource, *Response, error) {
+	return s.CreateContext(context.Background(), leadSource)
+}
+
+func (s *LeadSourcesServiceOp) CreateContext(ctx context.Context, leadSource *LeadSource) (*LeadSource, *Response, error) {
+	u := "/v2/lead_sources"
+	envelope := &leadSourceRoot{LeadSource: leadSource}
+	req, err := s.client.NewRequestContext(ctx, "POST", u, envelope)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(leadSourceRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return root.LeadSource, res, err
+}
+
+func (s *LeadSourcesServiceOp) Edit(id int, leadSource *LeadSource) (*LeadSource, *Response, error) {
+	return s.EditContext(context.Background(), id, leadSource)
+}
+
+func (s *LeadSourcesServiceOp) EditContext(ctx context.Context, id int, leadSource *LeadSource) (*LeadSource, *Response, error) {
+	u := fmt.Sprintf("/v2/lead_sources/%d", id)
+	envelope := &leadSourceRoot{LeadSource: leadSource}
+	req, err := s.client.NewRequestContext(ctx, "PUT", u, envelope)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(leadSourceRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return root.LeadSource, res, err
+}
+
+func (s *LeadSourcesServiceOp) Delete(id int) (bool, *Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+func (s *LeadSourcesServiceOp) DeleteContext(ctx context.Context, id int) (bool, *Response, error) {
+	u := fmt.Sprintf("/v2/lead_sources/%d", id)
+	req, err := s.client.NewRequestContext(ctx, "DELETE", u, nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	res, err := s.client.Do(req, nil)
+	if err != nil {
+		return false, res, err
+	}
+
+	return res.StatusCode == http.StatusNoContent, res, err
+}
@@ -0,0 +1,230 @@
+package basecrm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+func TestLeadSourcesService(t *testing.T) { TestingT(t) }
+
+type LeadSourcesSuite struct {
+}
+
+var _ = Suite(&LeadSourcesSuite{})
+
+func (s *LeadSourcesSuite) TestLeadSourcesService_List_All(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/lead_sources", func(w http.ResponseWriter, req *http.Request) {
+		expected := map[string]string{
+			"creator_id":        "1",
+			"name":              "Word of mouth",
+			"marketing_channel": "referral",
+			"page":              "1",
+			"per_page":          "25",
+			"ids":               "1,2,3",
+			"sort_by":           "name:desc,created_at:asc",
+		}
+		c.Assert(req, HasHttpMethod, "GET")
+		c.Assert(req, HasHttpHeader, "Accept", "application/json")
+		c.Assert(req, HasQueryParams, expected)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+     {
+      "items": [{
+          "data": {
+            "id": 1
+          },
+          "meta": {
+            "type": "lead_source"
+          }
+      }, {
+          "data": {
+            "id": 2
+          },
+          "meta": {
+            "type": "lead_source"
+          }
+      }],
+      "meta": {
+        "type": "collection",
+        "count": 2,
+        "links": {
+          "self": "http://api.getbase.com/v2/lead_sources.json"
+        }
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	opt := &LeadSourceListOptions{
+		CreatorId:        1,
+		Name:             "Word of mouth",
+		MarketingChannel: "referral",
+		ListOptions: ListOptions{
+			Page:    1,
+			PerPage: 25,
+			Ids:     []int{1, 2, 3},
+			SortBy:  []string{"name:desc", "created_at:asc"},
+		},
+	}
+	leadSources, res, err := client.LeadSources.List(opt)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(leadSources, NotNil)
+
+	c.Assert(len(leadSources), Equals, 2)
+	c.Assert(leadSources[0].Id, Equals, 1)
+	c.Assert(leadSources[1].Id, Equals, 2)
+}
+
+func (s *LeadSourcesSuite) TestLeadSourcesService_Get(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/lead_sources/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "creator_id": 1,
+        "name": "Word of mouth",
+        "resource_type": "lead",
+        "marketing_channel": "referral",
+        "created_at": "2014-08-27T16:32:56Z",
+        "updated_at": "2014-08-27T16:32:56Z"
+      },
+      "meta": {
+        "type": "lead_source"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	leadSource, res, err := client.LeadSources.Get(1)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(leadSource, NotNil)
+
+	c.Assert(leadSource.Id, Equals, 1)
+	c.Assert(leadSource.Name, Equals, "Word of mouth")
+	c.Assert(leadSource.ResourceType, Equals, LeadResource)
+	c.Assert(leadSource.MarketingChannel, Equals, "referral")
+}
+
+func (s *LeadSourcesSuite) TestLeadSourcesService_Create(c *C) {
+	setup()
+	defer teardown()
+
+	input := &LeadSource{
+		Name: "Tom referral",
+	}
+
+	expected := &LeadSource{
+		Id:   1,
+		Name: "Tom referral",
+	}
+
+	mux.HandleFunc("/v2/lead_sources", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "POST")
+
+		root := new(leadSourceRoot)
+		json.NewDecoder(req.Body).Decode(root)
+		c.Assert(root.LeadSource, NotNil)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "name": "Tom referral"
+      },
+      "meta": {
+        "type": "lead_source"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	leadSource, res, err := client.LeadSources.Create(input)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(leadSource, NotNil)
+
+	c.Assert(leadSource, DeepEquals, expected)
+}
+
+func (s *LeadSourcesSuite) TestLeadSourcesService_Edit(c *C) {
+	setup()
+	defer teardown()
+
+	input := &LeadSource{
+		Name: "Tom",
+	}
+
+	expected := &LeadSource{
+		Id:   1,
+		Name: "Tom",
+	}
+
+	mux.HandleFunc("/v2/lead_sources/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "PUT")
+
+		root := new(leadSourceRoot)
+		json.NewDecoder(req.Body).Decode(root)
+		c.Assert(root.LeadSource, NotNil)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "name": "Tom"
+      },
+      "meta": {
+        "type": "lead_source"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	leadSource, res, err := client.LeadSources.Edit(1, input)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(leadSource, NotNil)
+
+	c.Assert(leadSource, DeepEquals, expected)
+}
+
+func (s *LeadSourcesSuite) TestLeadSourcesService_Delete(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/lead_sources/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "DELETE")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	deleted, res, err := client.LeadSources.Delete(1)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(deleted, Equals, true)
+}
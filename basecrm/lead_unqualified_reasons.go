@@ -0,0 +1,197 @@
+package basecrm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type LeadUnqualifiedReason struct {
+	Id        int       `json:"id,omitempty"`
+	CreatorId int       `json:"creator_id,omitempty"`
+	Name      string    `json:"name,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+
+	ForceSendFields []string `json:"-"`
+	NullFields      []string `json:"-"`
+}
+
+// MarshalJSON encodes the LeadUnqualifiedReason, sending the fields listed in ForceSendFields and NullFields.
+func (l LeadUnqualifiedReason) MarshalJSON() ([]byte, error) {
+	type leadUnqualifiedReason LeadUnqualifiedReason
+	return marshalFields(leadUnqualifiedReason(l), l.ForceSendFields, l.NullFields)
+}
+
+type LeadUnqualifiedReasonListOptions struct {
+	CreatorId int    `url:"creator_id,omitempty"`
+	Name      string `url:"name,omitempty"`
+
+	ListOptions
+}
+
+type LeadUnqualifiedReasonsService interface {
+	List(opt *LeadUnqualifiedReasonListOptions) ([]*LeadUnqualifiedReason, *Response, error)
+	ListContext(ctx context.Context, opt *LeadUnqualifiedReasonListOptions) ([]*LeadUnqualifiedReason, *Response, error)
+	ListAll(opt *LeadUnqualifiedReasonListOptions) *Iter[*LeadUnqualifiedReason]
+	ListAllContext(ctx context.Context, opt *LeadUnqualifiedReasonListOptions) *Iter[*LeadUnqualifiedReason]
+	Get(id int) (*LeadUnqualifiedReason, *Response, error)
+	GetContext(ctx context.Context, id int) (*LeadUnqualifiedReason, *Response, error)
+	Create(leadUnqualifiedReason *LeadUnqualifiedReason) (*LeadUnqualifiedReason, *Response, error)
+	CreateContext(ctx context.Context, leadUnqualifiedReason *LeadUnqualifiedReason) (*LeadUnqualifiedReason, *Response, error)
+	Edit(id int, leadUnqualifiedReason *LeadUnqualifiedReason) (*LeadUnqualifiedReason, *Response, error)
+	EditContext(ctx context.Context, id int, leadUnqualifiedReason *LeadUnqualifiedReason) (*LeadUnqualifiedReason, *Response, error)
+	Delete(id int) (bool, *Response, error)
+	DeleteContext(ctx context.Context, id int) (bool, *Response, error)
+}
+
+func NewLeadUnqualifiedReasonsService(client *Client) LeadUnqualifiedReasonsService {
+	return &LeadUnqualifiedReasonsServiceOp{client}
+}
+
+type leadUnqualifiedReasonRoot struct {
+	LeadUnqualifiedReason *LeadUnqualifiedReason `json:"data"`
+	Meta                  *struct {
+		Type string `json:"type,omitempty"`
+	} `json:"meta,omitempty"`
+}
+
+type leadUnqualifiedReasonsRoot struct {
+	Items []*leadUnqualifiedReasonRoot `json:"items"`
+	Meta  *Meta                        `json:"meta"`
+}
+
+func (r *leadUnqualifiedReasonsRoot) LeadUnqualifiedReasons() []*LeadUnqualifiedReason {
+	leadUnqualifiedReasons := make([]*LeadUnqualifiedReason, len(r.Items))
+	for i, root := range r.Items {
+		leadUnqualifiedReasons[i] = root.LeadUnqualifiedReason
+	}
+	return leadUnqualifiedReasons
+}
+
+type LeadUnqualifiedReasonsServiceOp struct {
+	client *Client
+}
+
+func (s *LeadUnqualifiedReasonsServiceOp) List(opt *LeadUnqualifiedReasonListOptions) ([]*LeadUnqualifiedReason, *Response, error) {
+	return s.ListContext(context.Background(), opt)
+}
+
+func (s *LeadUnqualifiedReasonsServiceOp) ListContext(ctx context.Context, opt *LeadUnqualifiedReasonListOptions) ([]*LeadUnqualifiedReason, *Response, error) {
+	u, err := addOptions("/v2/lead_unqualified_reasons", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(leadUnqualifiedReasonsRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	res.setMeta(root.Meta)
+
+	return root.LeadUnqualifiedReasons(), res, err
+}
+
+func (s *LeadUnqualifiedReasonsServiceOp) ListAll(opt *LeadUnqualifiedReasonListOptions) *Iter[*LeadUnqualifiedReason] {
+	return s.ListAllContext(context.Background(), opt)
+}
+
+func (s *LeadUnqualifiedReasonsServiceOp) ListAllContext(ctx context.Context, opt *LeadUnqualifiedReasonListOptions) *Iter[*LeadUnqualifiedReason] {
+	o := LeadUnqualifiedReasonListOptions{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIter(ctx, o.Page, func(ctx context.Context, page int) ([]*LeadUnqualifiedReason, *Response, error) {
+		o.Page = page
+		return s.ListContext(ctx, &o)
+	})
+}
+
+func (s *LeadUnqualifiedReasonsServiceOp) Get(id int) (*LeadUnqualifiedReason, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+func (s *LeadUnqualifiedReasonsServiceOp) GetContext(ctx context.Context, id int) (*LeadUnqualifiedReason, *Response, error) {
+	u := fmt.Sprintf("/v2/lead_unqualified_reasons/%d", id)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(leadUnqualifiedReasonRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return root.LeadUnqualifiedReason, res, err
+}
+
+func (s *LeadUnqualifiedReasonsServiceOp) Create(leadUnqualifiedReason *LeadUnqualifiedReason) (*LeadUnqualifiedReason, *Response, error) {
+	return s.CreateContext(context.Background(), leadUnqualifiedReason)
+}
+
+func (s *LeadUnqualifiedReasonsServiceOp) CreateContext(ctx context.Context, leadUnqualifiedReason *LeadUnqualifiedReason) (*LeadUnqualifiedReason, *Response, error) {
+	u := "/v2/lead_unqualified_reasons"
+	envelope := &leadUnqualifiedReasonRoot{LeadUnqualifiedReason: leadUnqualifiedReason}
+	req, err := s.client.NewRequestContext(ctx, "POST", u, envelope)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(leadUnqualifiedReasonRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return root.LeadUnqualifiedReason, res, err
+}
+
+func (s *LeadUnqualifiedReasonsServiceOp) Edit(id int, leadUnqualifiedReason *LeadUnqualifiedReason) (*LeadUnqualifiedReason, *Response, error) {
+	return s.EditContext(context.Background(), id, leadUnqualifiedReason)
+}
+
+func (s *LeadUnqualifiedReasonsServiceOp) EditContext(ctx context.Context, id int, leadUnqualifiedReason *LeadUnqualifiedReason) (*LeadUnqualifiedReason, *Response, error) {
+	u := fmt.Sprintf("/v2/lead_unqualified_reasons/%d", id)
+	envelope := &leadUnqualifiedReasonRoot{LeadUnqualifiedReason: leadUnqualifiedReason}
+	req, err := s.client.NewRequestContext(ctx, "PUT", u, envelope)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(leadUnqualifiedReasonRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return root.LeadUnqualifiedReason, res, err
+}
+
+func (s *LeadUnqualifiedReasonsServiceOp) Delete(id int) (bool, *Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+func (s *LeadUnqualifiedReasonsServiceOp) DeleteContext(ctx context.Context, id int) (bool, *Response, error) {
+	u := fmt.Sprintf("/v2/lead_unqualified_reasons/%d", id)
+	req, err := s.client.NewRequestContext(ctx, "DELETE", u, nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	res, err := s.client.Do(req, nil)
+	if err != nil {
+		return false, res, err
+	}
+
+	return res.StatusCode == http.StatusNoContent, res, err
+}
@@ -0,0 +1,224 @@
+package basecrm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+func TestLeadUnqualifiedReasonsService(t *testing.T) { TestingT(t) }
+
+type LeadUnqualifiedReasonsSuite struct {
+}
+
+var _ = Suite(&LeadUnqualifiedReasonsSuite{})
+
+func (s *LeadUnqualifiedReasonsSuite) TestLeadUnqualifiedReasonsService_List_All(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/lead_unqualified_reasons", func(w http.ResponseWriter, req *http.Request) {
+		expected := map[string]string{
+			"creator_id": "1",
+			"name":       "We were too expensive",
+			"page":       "1",
+			"per_page":   "25",
+			"ids":        "1,2,3",
+			"sort_by":    "name:desc,created_at:asc",
+		}
+		c.Assert(req, HasHttpMethod, "GET")
+		c.Assert(req, HasHttpHeader, "Accept", "application/json")
+		c.Assert(req, HasQueryParams, expected)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+     {
+      "items": [{
+          "data": {
+            "id": 1
+          },
+          "meta": {
+            "type": "lead_unqualified_reason"
+          }
+      }, {
+          "data": {
+            "id": 2
+          },
+          "meta": {
+            "type": "lead_unqualified_reason"
+          }
+      }],
+      "meta": {
+        "type": "collection",
+        "count": 2,
+        "links": {
+          "self": "http://api.getbase.com/v2/lead_unqualified_reasons.json"
+        }
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	opt := &LeadUnqualifiedReasonListOptions{
+		CreatorId: 1,
+		Name:      "We were too expensive",
+		ListOptions: ListOptions{
+			Page:    1,
+			PerPage: 25,
+			Ids:     []int{1, 2, 3},
+			SortBy:  []string{"name:desc", "created_at:asc"},
+		},
+	}
+	leadUnqualifiedReasons, res, err := client.LeadUnqualifiedReasons.List(opt)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(leadUnqualifiedReasons, NotNil)
+
+	c.Assert(len(leadUnqualifiedReasons), Equals, 2)
+	c.Assert(leadUnqualifiedReasons[0].Id, Equals, 1)
+	c.Assert(leadUnqualifiedReasons[1].Id, Equals, 2)
+}
+
+func (s *LeadUnqualifiedReasonsSuite) TestLeadUnqualifiedReasonsService_Get(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/lead_unqualified_reasons/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "creator_id": 1,
+        "name": "We were too expensive",
+        "created_at": "2014-08-27T16:32:56Z",
+        "updated_at": "2014-08-27T16:32:56Z"
+      },
+      "meta": {
+        "type": "lead_unqualified_reason"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	leadUnqualifiedReason, res, err := client.LeadUnqualifiedReasons.Get(1)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(leadUnqualifiedReason, NotNil)
+
+	c.Assert(leadUnqualifiedReason.Id, Equals, 1)
+	c.Assert(leadUnqualifiedReason.Name, Equals, "We were too expensive")
+}
+
+func (s *LeadUnqualifiedReasonsSuite) TestLeadUnqualifiedReasonsService_Create(c *C) {
+	setup()
+	defer teardown()
+
+	input := &LeadUnqualifiedReason{
+		Name: "We were too expensive",
+	}
+
+	expected := &LeadUnqualifiedReason{
+		Id:   1,
+		Name: "We were too expensive",
+	}
+
+	mux.HandleFunc("/v2/lead_unqualified_reasons", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "POST")
+
+		root := new(leadUnqualifiedReasonRoot)
+		json.NewDecoder(req.Body).Decode(root)
+		c.Assert(root.LeadUnqualifiedReason, NotNil)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "name": "We were too expensive"
+      },
+      "meta": {
+        "type": "lead_unqualified_reason"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	leadUnqualifiedReason, res, err := client.LeadUnqualifiedReasons.Create(input)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(leadUnqualifiedReason, NotNil)
+
+	c.Assert(leadUnqualifiedReason, DeepEquals, expected)
+}
+
+func (s *LeadUnqualifiedReasonsSuite) TestLeadUnqualifiedReasonsService_Edit(c *C) {
+	setup()
+	defer teardown()
+
+	input := &LeadUnqualifiedReason{
+		Name: "We were not ready",
+	}
+
+	expected := &LeadUnqualifiedReason{
+		Id:   1,
+		Name: "We were not ready",
+	}
+
+	mux.HandleFunc("/v2/lead_unqualified_reasons/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "PUT")
+
+		root := new(leadUnqualifiedReasonRoot)
+		json.NewDecoder(req.Body).Decode(root)
+		c.Assert(root.LeadUnqualifiedReason, NotNil)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "name": "We were not ready"
+      },
+      "meta": {
+        "type": "lead_unqualified_reason"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	leadUnqualifiedReason, res, err := client.LeadUnqualifiedReasons.Edit(1, input)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(leadUnqualifiedReason, NotNil)
+
+	c.Assert(leadUnqualifiedReason, DeepEquals, expected)
+}
+
+func (s *LeadUnqualifiedReasonsSuite) TestLeadUnqualifiedReasonsService_Delete(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/lead_unqualified_reasons/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "DELETE")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	deleted, res, err := client.LeadUnqualifiedReasons.Delete(1)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(deleted, Equals, true)
+}
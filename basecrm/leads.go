@@ -1,36 +1,47 @@
 package basecrm
 
 import (
+	"context"
 	"fmt"
 	"net/http"
-	"time"
 )
 
 type Lead struct {
-	Id               int                    `json:"id,omitempty"`
-	CreatorId        int                    `json:"creator_id,omitempty"`
-	OwnerId          int                    `json:"owner_id,omitempty"`
-	FirstName        string                 `json:"first_name,omitempty"`
-	LastName         string                 `json:"last_name,omitempty"`
-	OrganizationName string                 `json:"organization_name,omitempty"`
-	Status           string                 `json:"status,omitempty"`
-	Title            string                 `json:"title,omitempty"`
-	Description      string                 `json:"description,omitempty"`
-	Industry         string                 `json:"industry,omitempty"`
-	Website          string                 `json:"website,omitempty"`
-	Email            string                 `json:"email,omitempty"`
-	Phone            string                 `json:"phone,omitempty"`
-	Mobile           string                 `json:"mobile,omitempty"`
-	Fax              string                 `json:"fax,omitempty"`
-	Twitter          string                 `json:"twitter,omitempty"`
-	Facebook         string                 `json:"facebook,omitempty"`
-	Linkedin         string                 `json:"linkedin,omitempty"`
-	Skype            string                 `json:"skype,omitempty"`
-	Address          *Address               `json:"address,omitempty"`
-	Tags             []string               `json:"tags,omitempty"`
-	CustomFields     map[string]interface{} `json:"custom_fields,omitempty"`
-	UpdatedAt        time.Time              `json:"updated_at,omitempty"`
-	CreatedAt        time.Time              `json:"created_at,omitempty"`
+	Id                  int          `json:"id,omitempty"`
+	CreatorId           int          `json:"creator_id,omitempty"`
+	OwnerId             int          `json:"owner_id,omitempty"`
+	FirstName           string       `json:"first_name,omitempty"`
+	LastName            string       `json:"last_name,omitempty"`
+	OrganizationName    string       `json:"organization_name,omitempty"`
+	Status              string       `json:"status,omitempty"`
+	UnqualifiedReasonId int          `json:"unqualified_reason_id,omitempty"`
+	SourceId            int          `json:"source_id,omitempty"`
+	Title               string       `json:"title,omitempty"`
+	Description         string       `json:"description,omitempty"`
+	Industry            string       `json:"industry,omitempty"`
+	Website             string       `json:"website,omitempty"`
+	Email               string       `json:"email,omitempty"`
+	Phone               string       `json:"phone,omitempty"`
+	Mobile              string       `json:"mobile,omitempty"`
+	Fax                 string       `json:"fax,omitempty"`
+	Twitter             string       `json:"twitter,omitempty"`
+	Facebook            string       `json:"facebook,omitempty"`
+	Linkedin            string       `json:"linkedin,omitempty"`
+	Skype               string       `json:"skype,omitempty"`
+	Address             *Address     `json:"address,omitempty"`
+	Tags                []string     `json:"tags,omitempty"`
+	CustomFields        CustomFields `json:"custom_fields,omitempty"`
+	UpdatedAt           Timestamp    `json:"updated_at,omitempty"`
+	CreatedAt           Timestamp    `json:"created_at,omitempty"`
+
+	ForceSendFields []string `json:"-"`
+	NullFields      []string `json:"-"`
+}
+
+// MarshalJSON encodes the Lead, sending the fields listed in ForceSendFields and NullFields.
+func (l Lead) MarshalJSON() ([]byte, error) {
+	type lead Lead
+	return marshalFields(lead(l), l.ForceSendFields, l.NullFields)
 }
 
 type LeadListOptions struct {
@@ -55,10 +66,17 @@ type LeadListOptions struct {
 
 type LeadsService interface {
 	List(opt *LeadListOptions) ([]*Lead, *Response, error)
+	ListContext(ctx context.Context, opt *LeadListOptions) ([]*Lead, *Response, error)
+	ListAll(opt *LeadListOptions) *Iter[*Lead]
+	ListAllContext(ctx context.Context, opt *LeadListOptions) *Iter[*Lead]
 	Get(id int) (*Lead, *Response, error)
+	GetContext(ctx context.Context, id int) (*Lead, *Response, error)
 	Create(lead *Lead) (*Lead, *Response, error)
+	CreateContext(ctx context.Context, lead *Lead) (*Lead, *Response, error)
 	Edit(id int, lead *Lead) (*Lead, *Response, error)
+	EditContext(ctx context.Context, id int, lead *Lead) (*Lead, *Response, error)
 	Delete(id int) (bool, *Response, error)
+	DeleteContext(ctx context.Context, id int) (bool, *Response, error)
 }
 
 func NewLeadsService(client *Client) LeadsService {
@@ -90,12 +108,16 @@ type LeadsServiceOp struct {
 }
 
 func (s *LeadsServiceOp) List(opt *LeadListOptions) ([]*Lead, *Response, error) {
+	return s.ListContext(context.Background(), opt)
+}
+
+func (s *LeadsServiceOp) ListContext(ctx context.Context, opt *LeadListOptions) ([]*Lead, *Response, error) {
 	u, err := addOptions("/v2/leads", opt)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -106,12 +128,34 @@ func (s *LeadsServiceOp) List(opt *LeadListOptions) ([]*Lead, *Response, error)
 		return nil, res, err
 	}
 
+	res.setMeta(root.Meta)
+
 	return root.Leads(), res, err
 }
 
+func (s *LeadsServiceOp) ListAll(opt *LeadListOptions) *Iter[*Lead] {
+	return s.ListAllContext(context.Background(), opt)
+}
+
+func (s *LeadsServiceOp) ListAllContext(ctx context.Context, opt *LeadListOptions) *Iter[*Lead] {
+	o := LeadListOptions{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIter(ctx, o.Page, func(ctx context.Context, page int) ([]*Lead, *Response, error) {
+		o.Page = page
+		return s.ListContext(ctx, &o)
+	})
+}
+
 func (s *LeadsServiceOp) Get(id int) (*Lead, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+func (s *LeadsServiceOp) GetContext(ctx context.Context, id int) (*Lead, *Response, error) {
 	u := fmt.Sprintf("/v2/leads/%d", id)
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -126,9 +170,13 @@ func (s *LeadsServiceOp) Get(id int) (*Lead, *Response, error) {
 }
 
 func (s *LeadsServiceOp) Create(lead *Lead) (*Lead, *Response, error) {
+	return s.CreateContext(context.Background(), lead)
+}
+
+func (s *LeadsServiceOp) CreateContext(ctx context.Context, lead *Lead) (*Lead, *Response, error) {
 	u := "/v2/leads"
 	envelope := &leadRoot{Lead: lead}
-	req, err := s.client.NewRequest("POST", u, envelope)
+	req, err := s.client.NewRequestContext(ctx, "POST", u, envelope)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -143,9 +191,13 @@ func (s *LeadsServiceOp) Create(lead *Lead) (*Lead, *Response, error) {
 }
 
 func (s *LeadsServiceOp) Edit(id int, lead *Lead) (*Lead, *Response, error) {
+	return s.EditContext(context.Background(), id, lead)
+}
+
+func (s *LeadsServiceOp) EditContext(ctx context.Context, id int, lead *Lead) (*Lead, *Response, error) {
 	u := fmt.Sprintf("/v2/leads/%d", id)
 	envelope := &leadRoot{Lead: lead}
-	req, err := s.client.NewRequest("PUT", u, envelope)
+	req, err := s.client.NewRequestContext(ctx, "PUT", u, envelope)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -160,8 +212,12 @@ func (s *LeadsServiceOp) Edit(id int, lead *Lead) (*Lead, *Response, error) {
 }
 
 func (s *LeadsServiceOp) Delete(id int) (bool, *Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+func (s *LeadsServiceOp) DeleteContext(ctx context.Context, id int) (bool, *Response, error) {
 	u := fmt.Sprintf("/v2/leads/%d", id)
-	req, err := s.client.NewRequest("DELETE", u, nil)
+	req, err := s.client.NewRequestContext(ctx, "DELETE", u, nil)
 	if err != nil {
 		return false, nil, err
 	}
@@ -0,0 +1,183 @@
+package basecrm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type LineItem struct {
+	Id          int       `json:"id,omitempty"`
+	ProductId   int       `json:"product_id,omitempty"`
+	Name        string    `json:"name,omitempty"`
+	Sku         string    `json:"sku,omitempty"`
+	Description string    `json:"description,omitempty"`
+	Value       Decimal   `json:"value,omitempty"`
+	Price       Decimal   `json:"price,omitempty"`
+	Variation   string    `json:"variation,omitempty"`
+	Currency    string    `json:"currency,omitempty"`
+	Quantity    int       `json:"quantity,omitempty"`
+	UpdatedAt   Timestamp `json:"updated_at,omitempty"`
+	CreatedAt   Timestamp `json:"created_at,omitempty"`
+}
+
+// MarshalJSON encodes the LineItem, omitting its empty decimal and timestamp fields.
+func (l LineItem) MarshalJSON() ([]byte, error) {
+	type lineItem LineItem
+	return marshalFields(lineItem(l), nil, nil)
+}
+
+// Money returns the value of the line item in its currency.
+func (l *LineItem) Money() Money {
+	return Money{Amount: l.Value, Currency: l.Currency}
+}
+
+type LineItemListOptions struct {
+	Quantity int    `url:"quantity,omitempty"`
+	Value    string `url:"value,omitempty"`
+
+	ListOptions
+}
+
+type LineItemsService interface {
+	List(orderId int, opt *LineItemListOptions) ([]*LineItem, *Response, error)
+	ListContext(ctx context.Context, orderId int, opt *LineItemListOptions) ([]*LineItem, *Response, error)
+	ListAll(orderId int, opt *LineItemListOptions) *Iter[*LineItem]
+	ListAllContext(ctx context.Context, orderId int, opt *LineItemListOptions) *Iter[*LineItem]
+	Get(orderId, id int) (*LineItem, *Response, error)
+	GetContext(ctx context.Context, orderId, id int) (*LineItem, *Response, error)
+	Create(orderId int, lineItem *LineItem) (*LineItem, *Response, error)
+	CreateContext(ctx context.Context, orderId int, lineItem *LineItem) (*LineItem, *Response, error)
+	Delete(orderId, id int) (bool, *Response, error)
+	DeleteContext(ctx context.Context, orderId, id int) (bool, *Response, error)
+}
+
+func NewLineItemsService(client *Client) LineItemsService {
+	return &LineItemsServiceOp{client}
+}
+
+type lineItemRoot struct {
+	LineItem *LineItem `json:"data"`
+	Meta     *struct {
+		Type string `json:"type,omitempty"`
+	} `json:"meta,omitempty"`
+}
+
+type lineItemsRoot struct {
+	Items []*lineItemRoot `json:"items"`
+	Meta  *Meta           `json:"meta"`
+}
+
+func (r *lineItemsRoot) LineItems() []*LineItem {
+	lineItems := make([]*LineItem, len(r.Items))
+	for i, root := range r.Items {
+		lineItems[i] = root.LineItem
+	}
+	return lineItems
+}
+
+type LineItemsServiceOp struct {
+	client *Client
+}
+
+func (s *LineItemsServiceOp) List(orderId int, opt *LineItemListOptions) ([]*LineItem, *Response, error) {
+	return s.ListContext(context.Background(), orderId, opt)
+}
+
+func (s *LineItemsServiceOp) ListContext(ctx context.Context, orderId int, opt *LineItemListOptions) ([]*LineItem, *Response, error) {
+	u, err := addOptions(fmt.Sprintf("/v2/orders/%d/line_items", orderId), opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(lineItemsRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	res.setMeta(root.Meta)
+
+	return root.LineItems(), res, err
+}
+
+func (s *LineItemsServiceOp) ListAll(orderId int, opt *LineItemListOptions) *Iter[*LineItem] {
+	return s.ListAllContext(context.Background(), orderId, opt)
+}
+
+func (s *LineItemsServiceOp) ListAllContext(ctx context.Context, orderId int, opt *LineItemListOptions) *Iter[*LineItem] {
+	o := LineItemListOptions{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIter(ctx, o.Page, func(ctx context.Context, page int) ([]*LineItem, *Response, error) {
+		o.Page = page
+		return s.ListContext(ctx, orderId, &o)
+	})
+}
+
+func (s *LineItemsServiceOp) Get(orderId, id int) (*LineItem, *Response, error) {
+	return s.GetContext(context.Background(), orderId, id)
+}
+
+func (s *LineItemsServiceOp) GetContext(ctx context.Context, orderId, id int) (*LineItem, *Response, error) {
+	u := fmt.Sprintf("/v2/orders/%d/line_items/%d", orderId, id)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(lineItemRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return root.LineItem, res, err
+}
+
+func (s *LineItemsServiceOp) Create(orderId int, lineItem *LineItem) (*LineItem, *Response, error) {
+	return s.CreateContext(context.Background(), orderId, lineItem)
+}
+
+func (s *LineItemsServiceOp) CreateContext(ctx context.Context, orderId int, lineItem *LineItem) (*LineItem, *Response, error) {
+	u := fmt.Sprintf("/v2/orders/%d/line_items", orderId)
+	envelope := &lineItemRoot{LineItem: lineItem}
+	req, err := s.client.NewRequestContext(ctx, "POST", u, envelope)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(lineItemRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return root.LineItem, res, err
+}
+
+func (s *LineItemsServiceOp) Delete(orderId, id int) (bool, *Response, error) {
+	return s.DeleteContext(context.Background(), orderId, id)
+}
+
+func (s *LineItemsServiceOp) DeleteContext(ctx context.Context, orderId, id int) (bool, *Response, error) {
+	u := fmt.Sprintf("/v2/orders/%d/line_items/%d", orderId, id)
+	req, err := s.client.NewRequestContext(ctx, "DELETE", u, nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	res, err := s.client.Do(req, nil)
+	if err != nil {
+		return false, res, err
+	}
+
+	return res.StatusCode == http.StatusNoContent, res, err
+}
@@ -0,0 +1,190 @@
+package basecrm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+func TestLineItemsService(t *testing.T) { TestingT(t) }
+
+type LineItemsSuite struct {
+}
+
+var _ = Suite(&LineItemsSuite{})
+
+func (s *LineItemsSuite) TestLineItemsService_List_All(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/orders/1/line_items", func(w http.ResponseWriter, req *http.Request) {
+		expected := map[string]string{
+			"quantity": "2",
+			"value":    "3199.98",
+			"page":     "1",
+			"per_page": "25",
+			"ids":      "1,2,3",
+			"sort_by":  "name:desc,created_at:asc",
+		}
+		c.Assert(req, HasHttpMethod, "GET")
+		c.Assert(req, HasHttpHeader, "Accept", "application/json")
+		c.Assert(req, HasQueryParams, expected)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+     {
+      "items": [{
+          "data": {
+            "id": 1
+          },
+          "meta": {
+            "type": "line_item"
+          }
+      }, {
+          "data": {
+            "id": 2
+          },
+          "meta": {
+            "type": "line_item"
+          }
+      }],
+      "meta": {
+        "type": "collection",
+        "count": 2,
+        "links": {
+          "self": "http://api.getbase.com/v2/orders/1/line_items.json"
+        }
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	opt := &LineItemListOptions{
+		Quantity: 2,
+		Value:    "3199.98",
+		ListOptions: ListOptions{
+			Page:    1,
+			PerPage: 25,
+			Ids:     []int{1, 2, 3},
+			SortBy:  []string{"name:desc", "created_at:asc"},
+		},
+	}
+	lineItems, res, err := client.LineItems.List(1, opt)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(lineItems, NotNil)
+
+	c.Assert(len(lineItems), Equals, 2)
+	c.Assert(lineItems[0].Id, Equals, 1)
+	c.Assert(lineItems[1].Id, Equals, 2)
+}
+
+func (s *LineItemsSuite) TestLineItemsService_Get(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/orders/1/line_items/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "product_id": 1,
+        "value": "3199.98",
+        "price": "1599.99",
+        "variation": "0",
+        "currency": "USD",
+        "quantity": 2
+      },
+      "meta": {
+        "type": "line_item"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	lineItem, res, err := client.LineItems.Get(1, 1)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(lineItem, NotNil)
+
+	c.Assert(lineItem.Id, Equals, 1)
+	c.Assert(lineItem.ProductId, Equals, 1)
+	c.Assert(lineItem.Value, Equals, NewDecimal(319998, 2))
+	c.Assert(lineItem.Quantity, Equals, 2)
+}
+
+func (s *LineItemsSuite) TestLineItemsService_Create(c *C) {
+	setup()
+	defer teardown()
+
+	input := &LineItem{
+		ProductId: 1,
+		Quantity:  2,
+		Currency:  "USD",
+	}
+
+	expected := &LineItem{
+		Id:        1,
+		ProductId: 1,
+		Quantity:  2,
+		Currency:  "USD",
+	}
+
+	mux.HandleFunc("/v2/orders/1/line_items", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "POST")
+
+		root := new(lineItemRoot)
+		json.NewDecoder(req.Body).Decode(root)
+		c.Assert(root.LineItem, NotNil)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "product_id": 1,
+        "quantity": 2,
+        "currency": "USD"
+      },
+      "meta": {
+        "type": "line_item"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	lineItem, res, err := client.LineItems.Create(1, input)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(lineItem, NotNil)
+
+	c.Assert(lineItem, DeepEquals, expected)
+}
+
+func (s *LineItemsSuite) TestLineItemsService_Delete(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/orders/1/line_items/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "DELETE")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	deleted, res, err := client.LineItems.Delete(1, 1)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(deleted, Equals, true)
+}
@@ -1,17 +1,26 @@
 package basecrm
 
 import (
+	"context"
 	"fmt"
 	"net/http"
-	"time"
 )
 
 type LossReason struct {
 	Id        int       `json:"id,omitempty"`
 	CreatorId int       `json:"creator_id,omitempty"`
 	Name      string    `json:"name,omitempty"`
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
-	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+
+	ForceSendFields []string `json:"-"`
+	NullFields      []string `json:"-"`
+}
+
+// MarshalJSON encodes the LossReason, sending the fields listed in ForceSendFields and NullFields.
+func (l LossReason) MarshalJSON() ([]byte, error) {
+	type lossReason LossReason
+	return marshalFields(lossReason(l), l.ForceSendFields, l.NullFields)
 }
 
 type LossReasonListOptions struct {
@@ -23,10 +32,17 @@ type LossReasonListOptions struct {
 
 type LossReasonsService interface {
 	List(opt *LossReasonListOptions) ([]*LossReason, *Response, error)
+	ListContext(ctx context.Context, opt *LossReasonListOptions) ([]*LossReason, *Response, error)
+	ListAll(opt *LossReasonListOptions) *Iter[*LossReason]
+	ListAllContext(ctx context.Context, opt *LossReasonListOptions) *Iter[*LossReason]
 	Get(id int) (*LossReason, *Response, error)
+	GetContext(ctx context.Context, id int) (*LossReason, *Response, error)
 	Create(lossReason *LossReason) (*LossReason, *Response, error)
+	CreateContext(ctx context.Context, lossReason *LossReason) (*LossReason, *Response, error)
 	Edit(id int, lossReason *LossReason) (*LossReason, *Response, error)
+	EditContext(ctx context.Context, id int, lossReason *LossReason) (*LossReason, *Response, error)
 	Delete(id int) (bool, *Response, error)
+	DeleteContext(ctx context.Context, id int) (bool, *Response, error)
 }
 
 func NewLossReasonsService(client *Client) LossReasonsService {
@@ -58,12 +74,16 @@ type LossReasonsServiceOp struct {
 }
 
 func (s *LossReasonsServiceOp) List(opt *LossReasonListOptions) ([]*LossReason, *Response, error) {
+	return s.ListContext(context.Background(), opt)
+}
+
+func (s *LossReasonsServiceOp) ListContext(ctx context.Context, opt *LossReasonListOptions) ([]*LossReason, *Response, error) {
 	u, err := addOptions("/v2/loss_reasons", opt)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -74,12 +94,34 @@ func (s *LossReasonsServiceOp) List(opt *LossReasonListOptions) ([]*LossReason,
 		return nil, res, err
 	}
 
+	res.setMeta(root.Meta)
+
 	return root.LossReasons(), res, err
 }
 
+func (s *LossReasonsServiceOp) ListAll(opt *LossReasonListOptions) *Iter[*LossReason] {
+	return s.ListAllContext(context.Background(), opt)
+}
+
+func (s *LossReasonsServiceOp) ListAllContext(ctx context.Context, opt *LossReasonListOptions) *Iter[*LossReason] {
+	o := LossReasonListOptions{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIter(ctx, o.Page, func(ctx context.Context, page int) ([]*LossReason, *Response, error) {
+		o.Page = page
+		return s.ListContext(ctx, &o)
+	})
+}
+
 func (s *LossReasonsServiceOp) Get(id int) (*LossReason, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+func (s *LossReasonsServiceOp) GetContext(ctx context.Context, id int) (*LossReason, *Response, error) {
 	u := fmt.Sprintf("/v2/loss_reasons/%d", id)
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -94,9 +136,13 @@ func (s *LossReasonsServiceOp) Get(id int) (*LossReason, *Response, error) {
 }
 
 func (s *LossReasonsServiceOp) Create(lossReason *LossReason) (*LossReason, *Response, error) {
+	return s.CreateContext(context.Background(), lossReason)
+}
+
+func (s *LossReasonsServiceOp) CreateContext(ctx context.Context, lossReason *LossReason) (*LossReason, *Response, error) {
 	u := "/v2/loss_reasons"
 	envelope := &lossReasonRoot{LossReason: lossReason}
-	req, err := s.client.NewRequest("POST", u, envelope)
+	req, err := s.client.NewRequestContext(ctx, "POST", u, envelope)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -111,9 +157,13 @@ func (s *LossReasonsServiceOp) Create(lossReason *LossReason) (*LossReason, *Res
 }
 
 func (s *LossReasonsServiceOp) Edit(id int, lossReason *LossReason) (*LossReason, *Response, error) {
+	return s.EditContext(context.Background(), id, lossReason)
+}
+
+func (s *LossReasonsServiceOp) EditContext(ctx context.Context, id int, lossReason *LossReason) (*LossReason, *Response, error) {
 	u := fmt.Sprintf("/v2/loss_reasons/%d", id)
 	envelope := &lossReasonRoot{LossReason: lossReason}
-	req, err := s.client.NewRequest("PUT", u, envelope)
+	req, err := s.client.NewRequestContext(ctx, "PUT", u, envelope)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -128,8 +178,12 @@ func (s *LossReasonsServiceOp) Edit(id int, lossReason *LossReason) (*LossReason
 }
 
 func (s *LossReasonsServiceOp) Delete(id int) (bool, *Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+func (s *LossReasonsServiceOp) DeleteContext(ctx context.Context, id int) (bool, *Response, error) {
 	u := fmt.Sprintf("/v2/loss_reasons/%d", id)
-	req, err := s.client.NewRequest("DELETE", u, nil)
+	req, err := s.client.NewRequestContext(ctx, "DELETE", u, nil)
 	if err != nil {
 		return false, nil, err
 	}
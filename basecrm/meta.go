@@ -7,6 +7,7 @@ type Meta struct {
 }
 
 type Links struct {
+	Self  string `json:"self,omitempty"`
 	First string `json:"first_page,omitempty"`
 	Last  string `json:"last_page,omitempty"`
 	Prev  string `json:"prev_page,omitempty"`
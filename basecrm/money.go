@@ -0,0 +1,68 @@
+package basecrm
+
+import "fmt"
+
+// Money is an amount in a currency, such as the value of a deal.
+type Money struct {
+	Amount Decimal
+	// Currency is the ISO 4217 code of the currency, e.g. "USD".
+	Currency string
+}
+
+// NewMoney returns the amount in the currency.
+func NewMoney(amount Decimal, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// Add returns m + m2. It fails when the amounts are in different currencies;
+// an amount without a currency takes the currency of the other one.
+func (m Money) Add(m2 Money) (Money, error) {
+	currency, err := m.currency(m2)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: m.Amount.Add(m2.Amount), Currency: currency}, nil
+}
+
+// Sub returns m - m2. It fails when the amounts are in different currencies.
+func (m Money) Sub(m2 Money) (Money, error) {
+	return m.Add(m2.Neg())
+}
+
+// Mul returns the amount multiplied by n, e.g. the price of a product by a quantity.
+func (m Money) Mul(n Decimal) Money {
+	return Money{Amount: m.Amount.Mul(n), Currency: m.Currency}
+}
+
+// Neg returns -m.
+func (m Money) Neg() Money {
+	return Money{Amount: m.Amount.Neg(), Currency: m.Currency}
+}
+
+// IsZero reports whether the amount is 0.
+func (m Money) IsZero() bool {
+	return m.Amount.IsZero()
+}
+
+// String formats the amount followed by the currency, e.g. "1000.50 USD".
+func (m Money) String() string {
+	if m.Currency == "" {
+		return m.Amount.String()
+	}
+	return m.Amount.String() + " " + m.Currency
+}
+
+// StringFixed is like String, with the amount rounded to the given number of decimal places.
+func (m Money) StringFixed(places int32) string {
+	return Money{Amount: m.Amount.Round(places), Currency: m.Currency}.String()
+}
+
+func (m Money) currency(m2 Money) (string, error) {
+	switch {
+	case m.Currency == "":
+		return m2.Currency, nil
+	case m2.Currency == "" || m2.Currency == m.Currency:
+		return m.Currency, nil
+	}
+	return "", fmt.Errorf("basecrm: currency mismatch: %s and %s", m.Currency, m2.Currency)
+}
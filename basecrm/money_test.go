@@ -0,0 +1,76 @@
+package basecrm
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+func TestMoney(t *testing.T) { TestingT(t) }
+
+type MoneySuite struct {
+}
+
+var _ = Suite(&MoneySuite{})
+
+func (s *MoneySuite) TestMoney_Arithmetic(c *C) {
+	price := NewMoney(MustParseDecimal("1599.99"), "USD")
+
+	total := price.Mul(NewDecimal(2, 0))
+	c.Assert(total.String(), Equals, "3199.98 USD")
+
+	sum, err := total.Add(NewMoney(MustParseDecimal("0.02"), ""))
+	c.Assert(err, IsNil)
+	c.Assert(sum.String(), Equals, "3200.00 USD")
+	c.Assert(sum.StringFixed(0), Equals, "3200 USD")
+
+	diff, err := sum.Sub(price)
+	c.Assert(err, IsNil)
+	c.Assert(diff.String(), Equals, "1600.01 USD")
+
+	_, err = price.Add(NewMoney(NewDecimal(1, 0), "EUR"))
+	c.Assert(err, ErrorMatches, "basecrm: currency mismatch: USD and EUR")
+
+	c.Assert(NewMoney(NewDecimal(5, 1), "").String(), Equals, "0.5")
+	c.Assert(Money{}.IsZero(), Equals, true)
+}
+
+func (s *MoneySuite) TestDeal_Value(c *C) {
+	var deal Deal
+	err := json.Unmarshal([]byte(`{"name": "Website Redesign", "value": "1000.50", "currency": "USD"}`), &deal)
+	c.Assert(err, IsNil)
+	c.Assert(deal.Money().String(), Equals, "1000.50 USD")
+
+	err = json.Unmarshal([]byte(`{"value": 1000}`), &deal)
+	c.Assert(err, IsNil)
+	c.Assert(deal.Value, Equals, NewDecimal(1000, 0))
+
+	deal = Deal{Name: "Website Redesign"}
+	data, err := json.Marshal(&deal)
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Not(Matches), `.*"value".*`)
+
+	deal.SetMoney(NewMoney(MustParseDecimal("99.90"), "EUR"))
+	data, err = json.Marshal(&deal)
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Matches, `.*"currency":"EUR".*`)
+	c.Assert(string(data), Matches, `.*"value":99.90.*`)
+
+	deal = Deal{ForceSendFields: []string{"Value"}}
+	data, err = json.Marshal(&deal)
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Matches, `.*"value":0.*`)
+}
+
+func (s *MoneySuite) TestLineItem_Value(c *C) {
+	data, err := json.Marshal(&LineItem{ProductId: 1, Quantity: 2})
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Not(Matches), `.*"(value|price)".*`)
+
+	var lineItem LineItem
+	err = json.Unmarshal([]byte(`{"value": "3199.98", "price": 1599.99, "currency": "USD"}`), &lineItem)
+	c.Assert(err, IsNil)
+	c.Assert(lineItem.Money().String(), Equals, "3199.98 USD")
+	c.Assert(lineItem.Price.Mul(NewDecimal(2, 0)).Equal(lineItem.Value), Equals, true)
+}
@@ -1,9 +1,9 @@
 package basecrm
 
 import (
+	"context"
 	"fmt"
 	"net/http"
-	"time"
 )
 
 type Note struct {
@@ -12,8 +12,17 @@ type Note struct {
 	ResourceType ResourceType `json:"resource_type,omitempty"`
 	ResourceId   int          `json:"resource_id,omitempty"`
 	Content      string       `json:"content,omitempty"`
-	UpdatedAt    time.Time    `json:"updated_at,omitempty"`
-	CreatedAt    time.Time    `json:"created_at,omitempty"`
+	UpdatedAt    Timestamp    `json:"updated_at,omitempty"`
+	CreatedAt    Timestamp    `json:"created_at,omitempty"`
+
+	ForceSendFields []string `json:"-"`
+	NullFields      []string `json:"-"`
+}
+
+// MarshalJSON encodes the Note, sending the fields listed in ForceSendFields and NullFields.
+func (n Note) MarshalJSON() ([]byte, error) {
+	type note Note
+	return marshalFields(note(n), n.ForceSendFields, n.NullFields)
 }
 
 type NoteListOptions struct {
@@ -29,10 +38,17 @@ type NoteListOptions struct {
 
 type NotesService interface {
 	List(opt *NoteListOptions) ([]*Note, *Response, error)
+	ListContext(ctx context.Context, opt *NoteListOptions) ([]*Note, *Response, error)
+	ListAll(opt *NoteListOptions) *Iter[*Note]
+	ListAllContext(ctx context.Context, opt *NoteListOptions) *Iter[*Note]
 	Get(id int) (*Note, *Response, error)
+	GetContext(ctx context.Context, id int) (*Note, *Response, error)
 	Create(note *Note) (*Note, *Response, error)
+	CreateContext(ctx context.Context, note *Note) (*Note, *Response, error)
 	Edit(id int, note *Note) (*Note, *Response, error)
+	EditContext(ctx context.Context, id int, note *Note) (*Note, *Response, error)
 	Delete(id int) (bool, *Response, error)
+	DeleteContext(ctx context.Context, id int) (bool, *Response, error)
 }
 
 func NewNotesService(client *Client) NotesService {
@@ -64,12 +80,16 @@ type NotesServiceOp struct {
 }
 
 func (s *NotesServiceOp) List(opt *NoteListOptions) ([]*Note, *Response, error) {
+	return s.ListContext(context.Background(), opt)
+}
+
+func (s *NotesServiceOp) ListContext(ctx context.Context, opt *NoteListOptions) ([]*Note, *Response, error) {
 	u, err := addOptions("/v2/notes", opt)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -80,12 +100,34 @@ func (s *NotesServiceOp) List(opt *NoteListOptions) ([]*Note, *Response, error)
 		return nil, res, err
 	}
 
+	res.setMeta(root.Meta)
+
 	return root.Notes(), res, err
 }
 
+func (s *NotesServiceOp) ListAll(opt *NoteListOptions) *Iter[*Note] {
+	return s.ListAllContext(context.Background(), opt)
+}
+
+func (s *NotesServiceOp) ListAllContext(ctx context.Context, opt *NoteListOptions) *Iter[*Note] {
+	o := NoteListOptions{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIter(ctx, o.Page, func(ctx context.Context, page int) ([]*Note, *Response, error) {
+		o.Page = page
+		return s.ListContext(ctx, &o)
+	})
+}
+
 func (s *NotesServiceOp) Get(id int) (*Note, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+func (s *NotesServiceOp) GetContext(ctx context.Context, id int) (*Note, *Response, error) {
 	u := fmt.Sprintf("/v2/notes/%d", id)
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -100,9 +142,13 @@ func (s *NotesServiceOp) Get(id int) (*Note, *Response, error) {
 }
 
 func (s *NotesServiceOp) Create(note *Note) (*Note, *Response, error) {
+	return s.CreateContext(context.Background(), note)
+}
+
+func (s *NotesServiceOp) CreateContext(ctx context.Context, note *Note) (*Note, *Response, error) {
 	u := "/v2/notes"
 	envelope := &noteRoot{Note: note}
-	req, err := s.client.NewRequest("POST", u, envelope)
+	req, err := s.client.NewRequestContext(ctx, "POST", u, envelope)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -117,9 +163,13 @@ func (s *NotesServiceOp) Create(note *Note) (*Note, *Response, error) {
 }
 
 func (s *NotesServiceOp) Edit(id int, note *Note) (*Note, *Response, error) {
+	return s.EditContext(context.Background(), id, note)
+}
+
+func (s *NotesServiceOp) EditContext(ctx context.Context, id int, note *Note) (*Note, *Response, error) {
 	u := fmt.Sprintf("/v2/notes/%d", id)
 	envelope := &noteRoot{Note: note}
-	req, err := s.client.NewRequest("PUT", u, envelope)
+	req, err := s.client.NewRequestContext(ctx, "PUT", u, envelope)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -134,8 +184,12 @@ func (s *NotesServiceOp) Edit(id int, note *Note) (*Note, *Response, error) {
 }
 
 func (s *NotesServiceOp) Delete(id int) (bool, *Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+func (s *NotesServiceOp) DeleteContext(ctx context.Context, id int) (bool, *Response, error) {
 	u := fmt.Sprintf("/v2/notes/%d", id)
-	req, err := s.client.NewRequest("DELETE", u, nil)
+	req, err := s.client.NewRequestContext(ctx, "DELETE", u, nil)
 	if err != nil {
 		return false, nil, err
 	}
@@ -0,0 +1,196 @@
+package basecrm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type Order struct {
+	Id        int       `json:"id,omitempty"`
+	DealId    int       `json:"deal_id,omitempty"`
+	Discount  int       `json:"discount,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+
+	ForceSendFields []string `json:"-"`
+	NullFields      []string `json:"-"`
+}
+
+// MarshalJSON encodes the Order, sending the fields listed in ForceSendFields and NullFields.
+func (o Order) MarshalJSON() ([]byte, error) {
+	type order Order
+	return marshalFields(order(o), o.ForceSendFields, o.NullFields)
+}
+
+type OrderListOptions struct {
+	DealId int `url:"deal_id,omitempty"`
+
+	ListOptions
+}
+
+type OrdersService interface {
+	List(opt *OrderListOptions) ([]*Order, *Response, error)
+	ListContext(ctx context.Context, opt *OrderListOptions) ([]*Order, *Response, error)
+	ListAll(opt *OrderListOptions) *Iter[*Order]
+	ListAllContext(ctx context.Context, opt *OrderListOptions) *Iter[*Order]
+	Get(id int) (*Order, *Response, error)
+	GetContext(ctx context.Context, id int) (*Order, *Response, error)
+	Create(order *Order) (*Order, *Response, error)
+	CreateContext(ctx context.Context, order *Order) (*Order, *Response, error)
+	Edit(id int, order *Order) (*Order, *Response, error)
+	EditContext(ctx context.Context, id int, order *Order) (*Order, *Response, error)
+	Delete(id int) (bool, *Response, error)
+	DeleteContext(ctx context.Context, id int) (bool, *Response, error)
+}
+
+func NewOrdersService(client *Client) OrdersService {
+	return &OrdersServiceOp{client}
+}
+
+type orderRoot struct {
+	Order *Order `json:"data"`
+	Meta  *struct {
+		Type string `json:"type,omitempty"`
+	} `json:"meta,omitempty"`
+}
+
+type ordersRoot struct {
+	Items []*orderRoot `json:"items"`
+	Meta  *Meta        `json:"meta"`
+}
+
+func (r *ordersRoot) Orders() []*Order {
+	orders := make([]*Order, len(r.Items))
+	for i, root := range r.Items {
+		orders[i] = root.Order
+	}
+	return orders
+}
+
+type OrdersServiceOp struct {
+	client *Client
+}
+
+func (s *OrdersServiceOp) List(opt *OrderListOptions) ([]*Order, *Response, error) {
+	return s.ListContext(context.Background(), opt)
+}
+
+func (s *OrdersServiceOp) ListContext(ctx context.Context, opt *OrderListOptions) ([]*Order, *Response, error) {
+	u, err := addOptions("/v2/orders", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ordersRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	res.setMeta(root.Meta)
+
+	return root.Orders(), res, err
+}
+
+func (s *OrdersServiceOp) ListAll(opt *OrderListOptions) *Iter[*Order] {
+	return s.ListAllContext(context.Background(), opt)
+}
+
+func (s *OrdersServiceOp) ListAllContext(ctx context.Context, opt *OrderListOptions) *Iter[*Order] {
+	o := OrderListOptions{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIter(ctx, o.Page, func(ctx context.Context, page int) ([]*Order, *Response, error) {
+		o.Page = page
+		return s.ListContext(ctx, &o)
+	})
+}
+
+func (s *OrdersServiceOp) Get(id int) (*Order, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+func (s *OrdersServiceOp) GetContext(ctx context.Context, id int) (*Order, *Response, error) {
+	u := fmt.Sprintf("/v2/orders/%d", id)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(orderRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return root.Order, res, err
+}
+
+func (s *OrdersServiceOp) Create(order *Order) (*Order, *Response, error) {
+	return s.CreateContext(context.Background(), order)
+}
+
+func (s *OrdersServiceOp) CreateContext(ctx context.Context, order *Order) (*Order, *Response, error) {
+	u := "/v2/orders"
+	envelope := &orderRoot{Order: order}
+	req, err := s.client.NewRequestContext(ctx, "POST", u, envelope)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(orderRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return root.Order, res, err
+}
+
+func (s *OrdersServiceOp) Edit(id int, order *Order) (*Order, *Response, error) {
+	return s.EditContext(context.Background(), id, order)
+}
+
+func (s *OrdersServiceOp) EditContext(ctx context.Context, id int, order *Order) (*Order, *Response, error) {
+	u := fmt.Sprintf("/v2/orders/%d", id)
+	envelope := &orderRoot{Order: order}
+	req, err := s.client.NewRequestContext(ctx, "PUT", u, envelope)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(orderRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return root.Order, res, err
+}
+
+func (s *OrdersServiceOp) Delete(id int) (bool, *Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+func (s *OrdersServiceOp) DeleteContext(ctx context.Context, id int) (bool, *Response, error) {
+	u := fmt.Sprintf("/v2/orders/%d", id)
+	req, err := s.client.NewRequestContext(ctx, "DELETE", u, nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	res, err := s.client.Do(req, nil)
+	if err != nil {
+		return false, res, err
+	}
+
+	return res.StatusCode == http.StatusNoContent, res, err
+}
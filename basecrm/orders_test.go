@@ -0,0 +1,224 @@
+package basecrm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+func TestOrdersService(t *testing.T) { TestingT(t) }
+
+type OrdersSuite struct {
+}
+
+var _ = Suite(&OrdersSuite{})
+
+func (s *OrdersSuite) TestOrdersService_List_All(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/orders", func(w http.ResponseWriter, req *http.Request) {
+		expected := map[string]string{
+			"deal_id":  "1",
+			"page":     "1",
+			"per_page": "25",
+			"ids":      "1,2,3",
+			"sort_by":  "name:desc,created_at:asc",
+		}
+		c.Assert(req, HasHttpMethod, "GET")
+		c.Assert(req, HasHttpHeader, "Accept", "application/json")
+		c.Assert(req, HasQueryParams, expected)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+     {
+      "items": [{
+          "data": {
+            "id": 1
+          },
+          "meta": {
+            "type": "order"
+          }
+      }, {
+          "data": {
+            "id": 2
+          },
+          "meta": {
+            "type": "order"
+          }
+      }],
+      "meta": {
+        "type": "collection",
+        "count": 2,
+        "links": {
+          "self": "http://api.getbase.com/v2/orders.json"
+        }
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	opt := &OrderListOptions{
+		DealId: 1,
+		ListOptions: ListOptions{
+			Page:    1,
+			PerPage: 25,
+			Ids:     []int{1, 2, 3},
+			SortBy:  []string{"name:desc", "created_at:asc"},
+		},
+	}
+	orders, res, err := client.Orders.List(opt)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(orders, NotNil)
+
+	c.Assert(len(orders), Equals, 2)
+	c.Assert(orders[0].Id, Equals, 1)
+	c.Assert(orders[1].Id, Equals, 2)
+}
+
+func (s *OrdersSuite) TestOrdersService_Get(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/orders/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "deal_id": 1,
+        "discount": 5
+      },
+      "meta": {
+        "type": "order"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	order, res, err := client.Orders.Get(1)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(order, NotNil)
+
+	c.Assert(order.Id, Equals, 1)
+	c.Assert(order.DealId, Equals, 1)
+	c.Assert(order.Discount, Equals, 5)
+}
+
+func (s *OrdersSuite) TestOrdersService_Create(c *C) {
+	setup()
+	defer teardown()
+
+	input := &Order{
+		DealId:   1,
+		Discount: 5,
+	}
+
+	expected := &Order{
+		Id:       1,
+		DealId:   1,
+		Discount: 5,
+	}
+
+	mux.HandleFunc("/v2/orders", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "POST")
+
+		root := new(orderRoot)
+		json.NewDecoder(req.Body).Decode(root)
+		c.Assert(root.Order, NotNil)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "deal_id": 1,
+        "discount": 5
+      },
+      "meta": {
+        "type": "order"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	order, res, err := client.Orders.Create(input)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(order, NotNil)
+
+	c.Assert(order, DeepEquals, expected)
+}
+
+func (s *OrdersSuite) TestOrdersService_Edit(c *C) {
+	setup()
+	defer teardown()
+
+	input := &Order{
+		Discount: 10,
+	}
+
+	expected := &Order{
+		Id:       1,
+		Discount: 10,
+	}
+
+	mux.HandleFunc("/v2/orders/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "PUT")
+
+		root := new(orderRoot)
+		json.NewDecoder(req.Body).Decode(root)
+		c.Assert(root.Order, NotNil)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "discount": 10
+      },
+      "meta": {
+        "type": "order"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	order, res, err := client.Orders.Edit(1, input)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(order, NotNil)
+
+	c.Assert(order, DeepEquals, expected)
+}
+
+func (s *OrdersSuite) TestOrdersService_Delete(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/orders/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "DELETE")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	deleted, res, err := client.Orders.Delete(1)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(deleted, Equals, true)
+}
@@ -0,0 +1,139 @@
+package basecrm
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// setMeta stores the collection meta data on the response and extracts
+// page numbers from the pagination links.
+func (r *Response) setMeta(meta *Meta) {
+	r.Meta = meta
+	if meta == nil || meta.Links == nil {
+		return
+	}
+
+	r.FirstPage = pageFromLink(meta.Links.First)
+	r.PrevPage = pageFromLink(meta.Links.Prev)
+	r.NextPage = pageFromLink(meta.Links.Next)
+	r.LastPage = pageFromLink(meta.Links.Last)
+}
+
+// pageFromLink returns the value of the page query parameter of the link, or 0.
+func pageFromLink(link string) int {
+	if link == "" {
+		return 0
+	}
+
+	u, err := url.Parse(link)
+	if err != nil {
+		return 0
+	}
+
+	page, err := strconv.Atoi(u.Query().Get("page"))
+	if err != nil {
+		return 0
+	}
+
+	return page
+}
+
+// Iter walks through all pages of a paginated collection, fetching the next page
+// only when the items of the current one have been consumed.
+//
+//	it := client.Deals.ListAll(&basecrm.DealListOptions{OwnerId: 1})
+//	for it.Next() {
+//		deal := it.Value()
+//		// ...
+//	}
+//	if err := it.Err(); err != nil {
+//		// handle error
+//	}
+type Iter[T any] struct {
+	ctx   context.Context
+	fetch func(ctx context.Context, page int) ([]T, *Response, error)
+
+	page  int
+	items []T
+	value T
+	res   *Response
+	err   error
+	done  bool
+}
+
+// NewIter returns an iterator fetching pages with fetch, starting at page. The
+// iterator moves on to res.NextPage after each page, and stops after a page
+// without items, a nil response or a response without a next page.
+//
+// The services create their iterators themselves; NewIter exists so that other
+// implementations of the service interfaces, such as the mocks in basecrmtest,
+// can return iterators from their ListAll methods.
+func NewIter[T any](ctx context.Context, page int, fetch func(ctx context.Context, page int) ([]T, *Response, error)) *Iter[T] {
+	return newIter(ctx, page, fetch)
+}
+
+func newIter[T any](ctx context.Context, page int, fetch func(ctx context.Context, page int) ([]T, *Response, error)) *Iter[T] {
+	if page < 1 {
+		page = 1
+	}
+	return &Iter[T]{ctx: ctx, fetch: fetch, page: page}
+}
+
+// Next advances the iterator to the next item, fetching the next page when needed.
+// It returns false when there are no more items or an error occurred.
+func (it *Iter[T]) Next() bool {
+	for len(it.items) == 0 {
+		if it.done || it.err != nil {
+			return false
+		}
+
+		items, res, err := it.fetch(it.ctx, it.page)
+		it.res = res
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		it.items = items
+		switch {
+		case len(items) == 0 || res == nil || res.NextPage == 0:
+			it.done = true
+		case res.NextPage <= it.page:
+			// a next page which does not advance would be fetched forever
+			it.err = fmt.Errorf("basecrm: next page %d does not advance past page %d", res.NextPage, it.page)
+			it.done = true
+		default:
+			it.page = res.NextPage
+		}
+	}
+
+	it.value = it.items[0]
+	it.items = it.items[1:]
+	return true
+}
+
+// Value returns the current item.
+func (it *Iter[T]) Value() T {
+	return it.value
+}
+
+// Err returns the first error encountered while fetching pages.
+func (it *Iter[T]) Err() error {
+	return it.err
+}
+
+// Response returns the response of the most recently fetched page.
+func (it *Iter[T]) Response() *Response {
+	return it.res
+}
+
+// All drains the iterator and returns all remaining items.
+func (it *Iter[T]) All() ([]T, error) {
+	var all []T
+	for it.Next() {
+		all = append(all, it.Value())
+	}
+	return all, it.Err()
+}
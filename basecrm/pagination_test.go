@@ -0,0 +1,140 @@
+package basecrm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+func TestPagination(t *testing.T) { TestingT(t) }
+
+type PaginationSuite struct {
+}
+
+var _ = Suite(&PaginationSuite{})
+
+func (s *PaginationSuite) TestResponse_Meta(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/contacts", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "items": [{
+        "data": {
+          "id": 1
+        },
+        "meta": {
+          "type": "contact"
+        }
+      }],
+      "meta": {
+        "type": "collection",
+        "count": 1,
+        "links": {
+          "self": "https://api.getbase.com/v2/contacts?page=2&per_page=1",
+          "first_page": "https://api.getbase.com/v2/contacts?page=1&per_page=1",
+          "prev_page": "https://api.getbase.com/v2/contacts?page=1&per_page=1",
+          "next_page": "https://api.getbase.com/v2/contacts?page=3&per_page=1"
+        }
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	_, res, err := client.Contacts.List(&ContactListOptions{ListOptions: ListOptions{Page: 2, PerPage: 1}})
+	c.Assert(err, IsNil)
+	c.Assert(res.Meta, NotNil)
+	c.Assert(res.Meta.Count, Equals, 1)
+	c.Assert(res.Meta.Links.Self, Equals, "https://api.getbase.com/v2/contacts?page=2&per_page=1")
+	c.Assert(res.FirstPage, Equals, 1)
+	c.Assert(res.PrevPage, Equals, 1)
+	c.Assert(res.NextPage, Equals, 3)
+	c.Assert(res.LastPage, Equals, 0)
+}
+
+func (s *PaginationSuite) TestIter_Deals(c *C) {
+	setup()
+	defer teardown()
+
+	pages := map[string]string{
+		"1": `{"items": [{"data": {"id": 1}}, {"data": {"id": 2}}],
+           "meta": {"type": "collection", "count": 2, "links": {"next_page": "https://api.getbase.com/v2/deals?page=2&per_page=2"}}}`,
+		"2": `{"items": [{"data": {"id": 3}}],
+           "meta": {"type": "collection", "count": 1, "links": {}}}`,
+	}
+
+	requests := 0
+	mux.HandleFunc("/v2/deals", func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		c.Assert(req.URL.Query().Get("owner_id"), Equals, "1")
+		c.Assert(req.URL.Query().Get("per_page"), Equals, "2")
+		fmt.Fprintf(w, pages[req.URL.Query().Get("page")])
+	})
+
+	opt := &DealListOptions{OwnerId: 1, ListOptions: ListOptions{PerPage: 2}}
+	it := client.Deals.ListAll(opt)
+
+	var ids []int
+	for it.Next() {
+		ids = append(ids, it.Value().Id)
+	}
+	c.Assert(it.Err(), IsNil)
+	c.Assert(ids, DeepEquals, []int{1, 2, 3})
+	c.Assert(requests, Equals, 2)
+	c.Assert(opt.Page, Equals, 0)
+	c.Assert(it.Next(), Equals, false)
+}
+
+func (s *PaginationSuite) TestIter_Empty(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/tags", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprintf(w, `{"items": [], "meta": {"type": "collection", "count": 0}}`)
+	})
+
+	tags, err := client.Tags.ListAll(nil).All()
+	c.Assert(err, IsNil)
+	c.Assert(tags, HasLen, 0)
+}
+
+func (s *PaginationSuite) TestIter_Error(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/users", func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get("page") == "2" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprintf(w, `{"items": [{"data": {"id": 1}}],
+      "meta": {"type": "collection", "links": {"next_page": "https://api.getbase.com/v2/users?page=2"}}}`)
+	})
+
+	users, err := client.Users.ListAll(nil).All()
+	c.Assert(users, HasLen, 1)
+	c.Assert(IsServerError(err), Equals, true)
+}
+
+func (s *PaginationSuite) TestIter_NextPageDoesNotAdvance(c *C) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/v2/users", func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		fmt.Fprintf(w, `{"items": [{"data": {"id": 1}}],
+      "meta": {"type": "collection", "links": {"next_page": "https://api.getbase.com/v2/users?page=1"}}}`)
+	})
+
+	users, err := client.Users.ListAll(nil).All()
+	c.Assert(users, HasLen, 1)
+	c.Assert(err, ErrorMatches, "basecrm: next page 1 does not advance past page 1")
+	c.Assert(requests, Equals, 1)
+}
@@ -0,0 +1,121 @@
+package basecrm
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Models sent to the API omit their empty fields, so an Edit only changes the
+// fields that are set. To change a field to its empty value, list the name of the
+// Go field in ForceSendFields; to clear it, list it in NullFields:
+//
+//	deal := &basecrm.Deal{
+//		Name:            "Website Redesign",
+//		ForceSendFields: []string{"Hot", "Value"},
+//		NullFields:      []string{"SourceId"},
+//	}
+//	client.Deals.Edit(1, deal) // {"name":"Website Redesign","hot":false,"value":0,"source_id":null}
+//
+// Fields listed in neither are sent only when they are not empty.
+
+// zeroer is implemented by the struct types of the package, such as Decimal, which
+// omitempty does not omit when zero.
+type zeroer interface {
+	IsZero() bool
+}
+
+var pkgPath = reflect.TypeOf(Decimal{}).PkgPath()
+
+// marshalFields encodes v, a struct without a MarshalJSON method, omitting its
+// empty zeroer fields, adding the fields of forceSend with their values even when
+// empty, and the fields of null as null.
+func marshalFields(v interface{}, forceSend, null []string) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	rv := reflect.ValueOf(v)
+	omit := zeroFields(rv)
+	if len(omit) == 0 && len(forceSend) == 0 && len(null) == 0 {
+		return data, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	for _, key := range omit {
+		delete(fields, key)
+	}
+	for _, name := range forceSend {
+		f, key, err := jsonField(rv, name)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case f.Kind() == reflect.Slice && f.IsNil():
+			fields[key] = json.RawMessage("[]")
+		case f.Kind() == reflect.Map && f.IsNil():
+			fields[key] = json.RawMessage("{}")
+		default:
+			raw, err := json.Marshal(f.Interface())
+			if err != nil {
+				return nil, err
+			}
+			fields[key] = raw
+		}
+	}
+	for _, name := range null {
+		_, key, err := jsonField(rv, name)
+		if err != nil {
+			return nil, err
+		}
+		fields[key] = json.RawMessage("null")
+	}
+
+	return json.Marshal(fields)
+}
+
+// zeroFields returns the JSON names of the omitempty fields of the struct v whose
+// types belong to the package and are zero.
+func zeroFields(v reflect.Value) []string {
+	var keys []string
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		key, opts, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		if !sf.IsExported() || sf.Type.PkgPath() != pkgPath || !strings.Contains(opts, "omitempty") {
+			continue
+		}
+		if z, ok := v.Field(i).Interface().(zeroer); ok && z.IsZero() {
+			if key == "" {
+				key = sf.Name
+			}
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// jsonField returns the value and the JSON name of the field of the struct v with
+// the given Go name.
+func jsonField(v reflect.Value, name string) (reflect.Value, string, error) {
+	sf, ok := v.Type().FieldByName(name)
+	if !ok || !sf.IsExported() {
+		return reflect.Value{}, "", fmt.Errorf("basecrm: unknown field %s", name)
+	}
+
+	key, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+	if key == "-" {
+		return reflect.Value{}, "", fmt.Errorf("basecrm: field %s is not sent", name)
+	}
+	if key == "" {
+		key = sf.Name
+	}
+	return v.FieldByIndex(sf.Index), key, nil
+}
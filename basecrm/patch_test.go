@@ -0,0 +1,95 @@
+package basecrm
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+func TestPatch(t *testing.T) { TestingT(t) }
+
+type PatchSuite struct {
+}
+
+var _ = Suite(&PatchSuite{})
+
+func (s *PatchSuite) TestMarshal_OmitsEmptyFields(c *C) {
+	data, err := json.Marshal(&Contact{LastName: "Smith"})
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, `{"last_name":"Smith"}`)
+}
+
+func (s *PatchSuite) TestMarshal_Values(c *C) {
+	deal := Deal{Name: "Website Redesign", ForceSendFields: []string{"Hot"}}
+
+	data, err := json.Marshal(deal)
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, `{"hot":false,"name":"Website Redesign"}`)
+
+	data, err = json.Marshal([]Deal{deal, {Name: "Mobile App"}})
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, `[{"hot":false,"name":"Website Redesign"},{"name":"Mobile App"}]`)
+}
+
+func (s *PatchSuite) TestMarshal_ForceSendAndNullFields(c *C) {
+	deal := &Deal{
+		Name:            "Website Redesign",
+		ForceSendFields: []string{"Hot", "Value", "Tags"},
+		NullFields:      []string{"SourceId", "CustomFields"},
+	}
+
+	data, err := json.Marshal(deal)
+	c.Assert(err, IsNil)
+
+	var fields map[string]interface{}
+	c.Assert(json.Unmarshal(data, &fields), IsNil)
+	c.Assert(fields, DeepEquals, map[string]interface{}{
+		"name":          "Website Redesign",
+		"hot":           false,
+		"value":         float64(0),
+		"tags":          []interface{}{},
+		"source_id":     nil,
+		"custom_fields": nil,
+	})
+}
+
+func (s *PatchSuite) TestMarshal_UnknownField(c *C) {
+	_, err := json.Marshal(&Task{ForceSendFields: []string{"Title"}})
+	c.Assert(err, ErrorMatches, ".*basecrm: unknown field Title")
+
+	_, err = json.Marshal(&Task{NullFields: []string{"NullFields"}})
+	c.Assert(err, ErrorMatches, ".*basecrm: field NullFields is not sent")
+}
+
+func (s *PatchSuite) TestEdit_SendsForcedFields(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/contacts/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "PUT")
+
+		body, _ := io.ReadAll(req.Body)
+		var root struct {
+			Data map[string]interface{} `json:"data"`
+		}
+		c.Assert(json.Unmarshal(body, &root), IsNil)
+		c.Assert(root.Data, DeepEquals, map[string]interface{}{
+			"title":   "",
+			"private": false,
+			"address": nil,
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		io.WriteString(w, `{"data": {"id": 1, "last_name": "Smith"}, "meta": {"type": "contact"}}`)
+	})
+
+	contact := &Contact{
+		ForceSendFields: []string{"Title", "Private"},
+		NullFields:      []string{"Address"},
+	}
+	_, _, err := client.Contacts.Edit(1, contact)
+	c.Assert(err, IsNil)
+}
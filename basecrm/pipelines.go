@@ -0,0 +1,126 @@
+package basecrm
+
+import (
+	"context"
+	"fmt"
+)
+
+type Pipeline struct {
+	Id        int       `json:"id,omitempty"`
+	Name      string    `json:"name,omitempty"`
+	Disabled  bool      `json:"disabled,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+}
+
+// MarshalJSON encodes the Pipeline, omitting its unset timestamps.
+func (p Pipeline) MarshalJSON() ([]byte, error) {
+	type pipeline Pipeline
+	return marshalFields(pipeline(p), nil, nil)
+}
+
+type PipelineListOptions struct {
+	Name     string `url:"name,omitempty"`
+	Disabled *bool  `url:"disabled,omitempty"`
+
+	ListOptions
+}
+
+type PipelinesService interface {
+	List(opt *PipelineListOptions) ([]*Pipeline, *Response, error)
+	ListContext(ctx context.Context, opt *PipelineListOptions) ([]*Pipeline, *Response, error)
+	ListAll(opt *PipelineListOptions) *Iter[*Pipeline]
+	ListAllContext(ctx context.Context, opt *PipelineListOptions) *Iter[*Pipeline]
+	Get(id int) (*Pipeline, *Response, error)
+	GetContext(ctx context.Context, id int) (*Pipeline, *Response, error)
+}
+
+func NewPipelinesService(client *Client) PipelinesService {
+	return &PipelinesServiceOp{client}
+}
+
+type pipelineRoot struct {
+	Pipeline *Pipeline `json:"data"`
+	Meta     *struct {
+		Type string `json:"type,omitempty"`
+	} `json:"meta,omitempty"`
+}
+
+type pipelinesRoot struct {
+	Items []*pipelineRoot `json:"items"`
+	Meta  *Meta           `json:"meta"`
+}
+
+func (r *pipelinesRoot) Pipelines() []*Pipeline {
+	pipelines := make([]*Pipeline, len(r.Items))
+	for i, root := range r.Items {
+		pipelines[i] = root.Pipeline
+	}
+	return pipelines
+}
+
+type PipelinesServiceOp struct {
+	client *Client
+}
+
+func (s *PipelinesServiceOp) List(opt *PipelineListOptions) ([]*Pipeline, *Response, error) {
+	return s.ListContext(context.Background(), opt)
+}
+
+func (s *PipelinesServiceOp) ListContext(ctx context.Context, opt *PipelineListOptions) ([]*Pipeline, *Response, error) {
+	u, err := addOptions("/v2/pipelines", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(pipelinesRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	res.setMeta(root.Meta)
+
+	return root.Pipelines(), res, err
+}
+
+func (s *PipelinesServiceOp) ListAll(opt *PipelineListOptions) *Iter[*Pipeline] {
+	return s.ListAllContext(context.Background(), opt)
+}
+
+func (s *PipelinesServiceOp) ListAllContext(ctx context.Context, opt *PipelineListOptions) *Iter[*Pipeline] {
+	o := PipelineListOptions{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIter(ctx, o.Page, func(ctx context.Context, page int) ([]*Pipeline, *Response, error) {
+		o.Page = page
+		return s.ListContext(ctx, &o)
+	})
+}
+
+func (s *PipelinesServiceOp) Get(id int) (*Pipeline, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+func (s *PipelinesServiceOp) GetContext(ctx context.Context, id int) (*Pipeline, *Response, error) {
+	u := fmt.Sprintf("/v2/pipelines/%d", id)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(pipelineRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return root.Pipeline, res, err
+}
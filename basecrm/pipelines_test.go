@@ -0,0 +1,119 @@
+package basecrm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+func TestPipelinesService(t *testing.T) { TestingT(t) }
+
+type PipelinesSuite struct {
+}
+
+var _ = Suite(&PipelinesSuite{})
+
+func (s *PipelinesSuite) TestPipelinesService_List_All(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/pipelines", func(w http.ResponseWriter, req *http.Request) {
+		expected := map[string]string{
+			"name":     "Sales",
+			"disabled": "true",
+			"page":     "1",
+			"per_page": "25",
+			"ids":      "1,2,3",
+			"sort_by":  "name:desc,created_at:asc",
+		}
+		c.Assert(req, HasHttpMethod, "GET")
+		c.Assert(req, HasHttpHeader, "Accept", "application/json")
+		c.Assert(req, HasQueryParams, expected)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+     {
+      "items": [{
+          "data": {
+            "id": 1
+          },
+          "meta": {
+            "type": "pipeline"
+          }
+      }, {
+          "data": {
+            "id": 2
+          },
+          "meta": {
+            "type": "pipeline"
+          }
+      }],
+      "meta": {
+        "type": "collection",
+        "count": 2,
+        "links": {
+          "self": "http://api.getbase.com/v2/pipelines.json"
+        }
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	opt := &PipelineListOptions{
+		Name:     "Sales",
+		Disabled: Bool(true),
+		ListOptions: ListOptions{
+			Page:    1,
+			PerPage: 25,
+			Ids:     []int{1, 2, 3},
+			SortBy:  []string{"name:desc", "created_at:asc"},
+		},
+	}
+	pipelines, res, err := client.Pipelines.List(opt)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(pipelines, NotNil)
+
+	c.Assert(len(pipelines), Equals, 2)
+	c.Assert(pipelines[0].Id, Equals, 1)
+	c.Assert(pipelines[1].Id, Equals, 2)
+}
+
+func (s *PipelinesSuite) TestPipelinesService_Get(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/pipelines/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "name": "Sales",
+        "disabled": false,
+        "created_at": "2014-08-27T16:32:56Z",
+        "updated_at": "2014-08-27T16:32:56Z"
+      },
+      "meta": {
+        "type": "pipeline"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	pipeline, res, err := client.Pipelines.Get(1)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(pipeline, NotNil)
+
+	c.Assert(pipeline.Id, Equals, 1)
+	c.Assert(pipeline.Name, Equals, "Sales")
+}
@@ -0,0 +1,222 @@
+package basecrm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type Price struct {
+	Amount   Decimal `json:"amount,omitempty"`
+	Currency string  `json:"currency,omitempty"`
+}
+
+// MarshalJSON encodes the Price, omitting its amount when empty.
+func (p Price) MarshalJSON() ([]byte, error) {
+	type price Price
+	return marshalFields(price(p), nil, nil)
+}
+
+// Money returns the amount of the price in its currency.
+func (p *Price) Money() Money {
+	return Money{Amount: p.Amount, Currency: p.Currency}
+}
+
+type Product struct {
+	Id           int       `json:"id,omitempty"`
+	Name         string    `json:"name,omitempty"`
+	Description  string    `json:"description,omitempty"`
+	Sku          string    `json:"sku,omitempty"`
+	Active       bool      `json:"active,omitempty"`
+	MaxDiscount  int       `json:"max_discount,omitempty"`
+	MaxMarkup    int       `json:"max_markup,omitempty"`
+	Cost         Decimal   `json:"cost,omitempty"`
+	CostCurrency string    `json:"cost_currency,omitempty"`
+	Prices       []*Price  `json:"prices,omitempty"`
+	UpdatedAt    Timestamp `json:"updated_at,omitempty"`
+	CreatedAt    Timestamp `json:"created_at,omitempty"`
+
+	ForceSendFields []string `json:"-"`
+	NullFields      []string `json:"-"`
+}
+
+// MarshalJSON encodes the Product, sending the fields listed in ForceSendFields and NullFields.
+func (p Product) MarshalJSON() ([]byte, error) {
+	type product Product
+	return marshalFields(product(p), p.ForceSendFields, p.NullFields)
+}
+
+type ProductListOptions struct {
+	Name string `url:"name,omitempty"`
+	Sku  string `url:"sku,omitempty"`
+
+	Active *bool `url:"active,omitempty"`
+
+	ListOptions
+}
+
+type ProductsService interface {
+	List(opt *ProductListOptions) ([]*Product, *Response, error)
+	ListContext(ctx context.Context, opt *ProductListOptions) ([]*Product, *Response, error)
+	ListAll(opt *ProductListOptions) *Iter[*Product]
+	ListAllContext(ctx context.Context, opt *ProductListOptions) *Iter[*Product]
+	Get(id int) (*Product, *Response, error)
+	GetContext(ctx context.Context, id int) (*Product, *Response, error)
+	Create(product *Product) (*Product, *Response, error)
+	CreateContext(ctx context.Context, product *Product) (*Product, *Response, error)
+	Edit(id int, product *Product) (*Product, *Response, error)
+	EditContext(ctx context.Context, id int, product *Product) (*Product, *Response, error)
+	Delete(id int) (bool, *Response, error)
+	DeleteContext(ctx context.Context, id int) (bool, *Response, error)
+}
+
+func NewProductsService(client *Client) ProductsService {
+	return &ProductsServiceOp{client}
+}
+
+type productRoot struct {
+	Product *Product `json:"data"`
+	Meta    *struct {
+		Type string `json:"type,omitempty"`
+	} `json:"meta,omitempty"`
+}
+
+type productsRoot struct {
+	Items []*productRoot `json:"items"`
+	Meta  *Meta          `json:"meta"`
+}
+
+func (r *productsRoot) Products() []*Product {
+	products := make([]*Product, len(r.Items))
+	for i, root := range r.Items {
+		products[i] = root.Product
+	}
+	return products
+}
+
+type ProductsServiceOp struct {
+	client *Client
+}
+
+func (s *ProductsServiceOp) List(opt *ProductListOptions) ([]*Product, *Response, error) {
+	return s.ListContext(context.Background(), opt)
+}
+
+func (s *ProductsServiceOp) ListContext(ctx context.Context, opt *ProductListOptions) ([]*Product, *Response, error) {
+	u, err := addOptions("/v2/products", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(productsRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	res.setMeta(root.Meta)
+
+	return root.Products(), res, err
+}
+
+func (s *ProductsServiceOp) ListAll(opt *ProductListOptions) *Iter[*Product] {
+	return s.ListAllContext(context.Background(), opt)
+}
+
+func (s *ProductsServiceOp) ListAllContext(ctx context.Context, opt *ProductListOptions) *Iter[*Product] {
+	o := ProductListOptions{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIter(ctx, o.Page, func(ctx context.Context, page int) ([]*Product, *Response, error) {
+		o.Page = page
+		return s.ListContext(ctx, &o)
+	})
+}
+
+func (s *ProductsServiceOp) Get(id int) (*Product, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+func (s *ProductsServiceOp) GetContext(ctx context.Context, id int) (*Product, *Response, error) {
+	u := fmt.Sprintf("/v2/products/%d", id)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(productRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return root.Product, res, err
+}
+
+func (s *ProductsServiceOp) Create(product *Product) (*Product, *Response, error) {
+	return s.CreateContext(context.Background(), product)
+}
+
+func (s *ProductsServiceOp) CreateContext(ctx context.Context, product *Product) (*Product, *Response, error) {
+	u := "/v2/products"
+	envelope := &productRoot{Product: product}
+	req, err := s.client.NewRequestContext(ctx, "POST", u, envelope)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(productRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return root.Product, res, err
+}
+
+func (s *ProductsServiceOp) Edit(id int, product *Product) (*Product, *Response, error) {
+	return s.EditContext(context.Background(), id, product)
+}
+
+func (s *ProductsServiceOp) EditContext(ctx context.Context, id int, product *Product) (*Product, *Response, error) {
+	u := fmt.Sprintf("/v2/products/%d", id)
+	envelope := &productRoot{Product: product}
+	req, err := s.client.NewRequestContext(ctx, "PUT", u, envelope)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(productRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return root.Product, res, err
+}
+
+func (s *ProductsServiceOp) Delete(id int) (bool, *Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+func (s *ProductsServiceOp) DeleteContext(ctx context.Context, id int) (bool, *Response, error) {
+	u := fmt.Sprintf("/v2/products/%d", id)
+	req, err := s.client.NewRequestContext(ctx, "DELETE", u, nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	res, err := s.client.Do(req, nil)
+	if err != nil {
+		return false, res, err
+	}
+
+	return res.StatusCode == http.StatusNoContent, res, err
+}
@@ -0,0 +1,249 @@
+package basecrm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+func TestProductsService(t *testing.T) { TestingT(t) }
+
+type ProductsSuite struct {
+}
+
+var _ = Suite(&ProductsSuite{})
+
+func (s *ProductsSuite) TestProductsService_List_All(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/products", func(w http.ResponseWriter, req *http.Request) {
+		expected := map[string]string{
+			"name":     "Enterprise Plan",
+			"sku":      "ep-1",
+			"active":   "true",
+			"page":     "1",
+			"per_page": "25",
+			"ids":      "1,2,3",
+			"sort_by":  "name:desc,created_at:asc",
+		}
+		c.Assert(req, HasHttpMethod, "GET")
+		c.Assert(req, HasHttpHeader, "Accept", "application/json")
+		c.Assert(req, HasQueryParams, expected)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+     {
+      "items": [{
+          "data": {
+            "id": 1
+          },
+          "meta": {
+            "type": "product"
+          }
+      }, {
+          "data": {
+            "id": 2
+          },
+          "meta": {
+            "type": "product"
+          }
+      }],
+      "meta": {
+        "type": "collection",
+        "count": 2,
+        "links": {
+          "self": "http://api.getbase.com/v2/products.json"
+        }
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	opt := &ProductListOptions{
+		Name:   "Enterprise Plan",
+		Sku:    "ep-1",
+		Active: Bool(true),
+		ListOptions: ListOptions{
+			Page:    1,
+			PerPage: 25,
+			Ids:     []int{1, 2, 3},
+			SortBy:  []string{"name:desc", "created_at:asc"},
+		},
+	}
+	products, res, err := client.Products.List(opt)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(products, NotNil)
+
+	c.Assert(len(products), Equals, 2)
+	c.Assert(products[0].Id, Equals, 1)
+	c.Assert(products[1].Id, Equals, 2)
+}
+
+func (s *ProductsSuite) TestProductsService_List_Inactive(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/products", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req, HasQueryParams, map[string]string{"active": "false"})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"items": [], "meta": {"type": "collection", "count": 0}}`)
+	})
+
+	_, _, err := client.Products.List(&ProductListOptions{Active: Bool(false)})
+	c.Assert(err, IsNil)
+}
+
+func (s *ProductsSuite) TestProductsService_Get(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/products/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "name": "Enterprise Plan",
+        "sku": "ep-1",
+        "active": true,
+        "max_discount": 10,
+        "cost": "2.00",
+        "cost_currency": "USD",
+        "prices": [{"amount": "1599.99", "currency": "USD"}]
+      },
+      "meta": {
+        "type": "product"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	product, res, err := client.Products.Get(1)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(product, NotNil)
+
+	c.Assert(product.Id, Equals, 1)
+	c.Assert(product.Sku, Equals, "ep-1")
+	c.Assert(product.Cost, Equals, NewDecimal(200, 2))
+	c.Assert(product.Prices[0], DeepEquals, &Price{Amount: MustParseDecimal("1599.99"), Currency: "USD"})
+}
+
+func (s *ProductsSuite) TestProductsService_Create(c *C) {
+	setup()
+	defer teardown()
+
+	input := &Product{
+		Name:   "Enterprise Plan",
+		Prices: []*Price{&Price{Amount: MustParseDecimal("1599.99"), Currency: "USD"}},
+	}
+
+	expected := &Product{
+		Id:     1,
+		Name:   "Enterprise Plan",
+		Prices: []*Price{&Price{Amount: MustParseDecimal("1599.99"), Currency: "USD"}},
+	}
+
+	mux.HandleFunc("/v2/products", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "POST")
+
+		root := new(productRoot)
+		json.NewDecoder(req.Body).Decode(root)
+		c.Assert(root.Product, NotNil)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "name": "Enterprise Plan",
+        "prices": [{"amount": "1599.99", "currency": "USD"}]
+      },
+      "meta": {
+        "type": "product"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	product, res, err := client.Products.Create(input)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(product, NotNil)
+
+	c.Assert(product, DeepEquals, expected)
+}
+
+func (s *ProductsSuite) TestProductsService_Edit(c *C) {
+	setup()
+	defer teardown()
+
+	input := &Product{
+		Name: "Enterprise Plan 2",
+	}
+
+	expected := &Product{
+		Id:   1,
+		Name: "Enterprise Plan 2",
+	}
+
+	mux.HandleFunc("/v2/products/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "PUT")
+
+		root := new(productRoot)
+		json.NewDecoder(req.Body).Decode(root)
+		c.Assert(root.Product, NotNil)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "name": "Enterprise Plan 2"
+      },
+      "meta": {
+        "type": "product"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	product, res, err := client.Products.Edit(1, input)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(product, NotNil)
+
+	c.Assert(product, DeepEquals, expected)
+}
+
+func (s *ProductsSuite) TestProductsService_Delete(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/products/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "DELETE")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	deleted, res, err := client.Products.Delete(1)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(deleted, Equals, true)
+}
@@ -1 +1,147 @@
 package basecrm
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	headerRateLimit     = "X-RateLimit-Limit"
+	headerRateRemaining = "X-RateLimit-Remaining"
+	headerRateReset     = "X-RateLimit-Reset"
+	headerRetryAfter    = "Retry-After"
+)
+
+// RateLimit represents the rate limit for the current client, as reported by the API
+// in the X-RateLimit-* response headers.
+type RateLimit struct {
+	// The maximum number of requests that the consumer is permitted to make in the current window.
+	Limit int
+
+	// The number of requests remaining in the current rate limit window.
+	Remaining int
+
+	// The time at which the current rate limit window resets.
+	Reset time.Time
+}
+
+// IsZero reports whether the rate limit has not been reported by the API.
+func (r RateLimit) IsZero() bool {
+	return r.Limit == 0 && r.Remaining == 0 && r.Reset.IsZero()
+}
+
+// RateLimitError occurs when the API responds with 429 Too Many Requests.
+type RateLimitError struct {
+	// The underlying API error.
+	*ErrorResponse
+
+	// Rate limit snapshot taken from the response
+	RateLimit RateLimit
+}
+
+func (r *RateLimitError) Error() string {
+	var res *http.Response
+	if r.ErrorResponse != nil {
+		res = r.Response
+	}
+
+	var buf bytes.Buffer
+	writeResponseLine(&buf, res)
+
+	if r.RateLimit.Limit > 0 {
+		fmt.Fprintf(&buf, " API rate limit of %d exceeded", r.RateLimit.Limit)
+	} else {
+		buf.WriteString(" API rate limit exceeded")
+	}
+	if !r.RateLimit.Reset.IsZero() {
+		fmt.Fprintf(&buf, ", reset at %v", r.RateLimit.Reset.Format(time.RFC3339))
+	}
+
+	if r.ErrorResponse != nil && r.RequestId != "" {
+		fmt.Fprintf(&buf, " (request_id=%s)", r.RequestId)
+	}
+
+	return buf.String()
+}
+
+// Unwrap returns the underlying ErrorResponse.
+func (r *RateLimitError) Unwrap() error {
+	return r.ErrorResponse
+}
+
+// RateLimit returns the most recent rate limit reported by the API. It returns
+// a zero RateLimit until the first response carrying rate limit headers has been received.
+func (c *Client) RateLimit() RateLimit {
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+
+	return c.rateLimit
+}
+
+func (c *Client) setRateLimit(rate RateLimit) {
+	if rate.IsZero() {
+		return
+	}
+
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+
+	c.rateLimit = rate
+}
+
+// parseRateLimit parses the rate limit headers of r. X-RateLimit-Reset is expressed
+// in UTC epoch seconds. When the API only sends Retry-After (delay in seconds or an HTTP date)
+// it is used to compute the reset time instead.
+func parseRateLimit(r *http.Response) RateLimit {
+	var rate RateLimit
+
+	if limit := r.Header.Get(headerRateLimit); limit != "" {
+		rate.Limit, _ = strconv.Atoi(limit)
+	}
+
+	if remaining := r.Header.Get(headerRateRemaining); remaining != "" {
+		rate.Remaining, _ = strconv.Atoi(remaining)
+	}
+
+	if reset := r.Header.Get(headerRateReset); reset != "" {
+		if v, err := strconv.ParseInt(reset, 10, 64); err == nil && v > 0 {
+			rate.Reset = time.Unix(v, 0).UTC()
+		}
+	}
+
+	if rate.Reset.IsZero() {
+		if d, ok := parseRetryAfter(r.Header.Get(headerRetryAfter)); ok {
+			rate.Reset = time.Now().Add(d).UTC().Truncate(time.Second)
+		}
+	}
+
+	return rate
+}
+
+// parseRetryAfter parses the value of the Retry-After header, which can be either
+// a number of seconds or an HTTP date.
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			secs = 0
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+
+	return 0, false
+}
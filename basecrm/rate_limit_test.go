@@ -0,0 +1,136 @@
+package basecrm
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func TestRateLimit(t *testing.T) { TestingT(t) }
+
+type RateLimitSuite struct {
+}
+
+var _ = Suite(&RateLimitSuite{})
+
+func (s *RateLimitSuite) TestRateLimit_Response(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/users/self", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		w.Header().Add("X-RateLimit-Limit", "36000")
+		w.Header().Add("X-RateLimit-Remaining", "35999")
+		w.Header().Add("X-RateLimit-Reset", "1420070400")
+
+		fmt.Fprintf(w, `{"data": {"id": 1}, "meta": {"type": "user"}}`)
+	})
+
+	c.Assert(client.RateLimit().IsZero(), Equals, true)
+
+	_, res, err := client.Users.Self()
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+
+	expected := RateLimit{
+		Limit:     36000,
+		Remaining: 35999,
+		Reset:     time.Date(2015, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+	c.Assert(res.RateLimit, DeepEquals, expected)
+	c.Assert(client.RateLimit(), DeepEquals, expected)
+}
+
+func (s *RateLimitSuite) TestRateLimit_KeepsLastSnapshot(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/users/1", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Add("X-RateLimit-Limit", "36000")
+		w.Header().Add("X-RateLimit-Remaining", "100")
+		fmt.Fprintf(w, `{"data": {"id": 1}}`)
+	})
+	mux.HandleFunc("/v2/users/2", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprintf(w, `{"data": {"id": 2}}`)
+	})
+
+	_, _, err := client.Users.Get(1)
+	c.Assert(err, IsNil)
+	_, res, err := client.Users.Get(2)
+	c.Assert(err, IsNil)
+
+	c.Assert(res.RateLimit.IsZero(), Equals, true)
+	c.Assert(client.RateLimit().Remaining, Equals, 100)
+}
+
+func (s *RateLimitSuite) TestRateLimit_Exceeded(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/deals", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		w.Header().Add("X-RateLimit-Limit", "36000")
+		w.Header().Add("X-RateLimit-Remaining", "0")
+		w.Header().Add("X-RateLimit-Reset", "1420070400")
+		w.WriteHeader(http.StatusTooManyRequests)
+
+		fmt.Fprintf(w, `
+    {
+      "errors": [{
+        "error": {
+          "code": "rate_limit_exceeded",
+          "message": "Rate limit exceeded"
+        }
+      }],
+      "meta": {
+        "type": "errors",
+        "http_status": "429 Too Many Requests",
+        "logref": "ab12"
+      }
+    }
+    `)
+	})
+
+	deals, res, err := client.Deals.List(nil)
+	c.Assert(deals, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(err, NotNil)
+
+	rateErr, ok := err.(*RateLimitError)
+	c.Assert(ok, Equals, true)
+	c.Assert(rateErr.RateLimit.Remaining, Equals, 0)
+	c.Assert(rateErr.RateLimit.Reset, DeepEquals, time.Date(2015, time.January, 1, 0, 0, 0, 0, time.UTC))
+	c.Assert(rateErr.Errors.Errors[0].Error.Code, Equals, "rate_limit_exceeded")
+	c.Assert(rateErr.RequestId, Equals, "ab12")
+	c.Assert(rateErr.Body, Not(HasLen), 0)
+	c.Assert(rateErr.Error(), Matches, `.*API rate limit of 36000 exceeded, reset at 2015-01-01T00:00:00Z \(request_id=ab12\)`)
+	c.Assert(client.RateLimit().Remaining, Equals, 0)
+
+	var errorResponse *ErrorResponse
+	c.Assert(errors.As(err, &errorResponse), Equals, true)
+	c.Assert(errorResponse.RequestId, Equals, "ab12")
+	c.Assert(IsRateLimited(err), Equals, true)
+}
+
+func (s *RateLimitSuite) TestRateLimit_RetryAfter(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/deals", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Add("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	before := time.Now()
+	_, _, err := client.Deals.List(nil)
+
+	rateErr, ok := err.(*RateLimitError)
+	c.Assert(ok, Equals, true)
+	c.Assert(rateErr.Error(), Matches, `.*429 Too Many Requests API rate limit exceeded, reset at .*`)
+	c.Assert(rateErr.RateLimit.Reset.After(before.Add(28*time.Second)), Equals, true)
+	c.Assert(rateErr.RateLimit.Reset.Before(before.Add(31*time.Second)), Equals, true)
+}
@@ -0,0 +1,226 @@
+package basecrm
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+const (
+	defaultMaxAttempts = 3
+	defaultBaseDelay   = 500 * time.Millisecond
+	defaultMaxDelay    = 30 * time.Second
+	defaultJitter      = 0.2
+)
+
+// RetryPolicy controls how Client.Do retries failed requests. Requests are retried
+// with exponential backoff: the n-th retry waits BaseDelay * 2^(n-1), capped at MaxDelay
+// and randomized by Jitter.
+//
+// Only idempotent methods (GET, HEAD, OPTIONS, PUT, DELETE) are retried unless RetryPost is set.
+type RetryPolicy struct {
+	// Maximum number of attempts, including the first one. Values lower than 2 disable retries.
+	MaxAttempts int
+
+	// Delay before the first retry.
+	BaseDelay time.Duration
+
+	// Upper bound of a single delay between attempts.
+	MaxDelay time.Duration
+
+	// Fraction of the delay, between 0 and 1, by which the delay is randomly increased or decreased.
+	Jitter float64
+
+	// HTTP status codes which are considered transient.
+	RetryableStatusCodes []int
+
+	// Reports whether a transport error is transient. If nil, IsRetryableError is used.
+	RetryableError func(err error) bool
+
+	// If set, the delay requested by the server in the Retry-After header is used instead
+	// of the computed backoff. Requests are not retried when the server asks to wait longer than MaxDelay.
+	RespectRetryAfter bool
+
+	// If set, POST requests are retried as well. Creating resources is not idempotent,
+	// so a retried POST may create duplicates.
+	RetryPost bool
+}
+
+// DefaultRetryPolicy returns a policy which makes up to 3 attempts, starting with a 500ms delay,
+// and retries on 429, 502, 503, 504 and transient network errors honoring Retry-After.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: defaultMaxAttempts,
+		BaseDelay:   defaultBaseDelay,
+		MaxDelay:    defaultMaxDelay,
+		Jitter:      defaultJitter,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RespectRetryAfter: true,
+	}
+}
+
+// IsRetryableError reports whether err returned by the http.Client is a transient network error,
+// such as a timeout, a reset connection or a connection closed before the response was read.
+func IsRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		return true
+	}
+
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNABORTED) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return false
+}
+
+// allows reports whether requests sent with the given method may be retried.
+func (p *RetryPolicy) allows(method string) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	case "POST":
+		return p.RetryPost
+	}
+	return false
+}
+
+// isRetryableStatus reports whether the status code is listed in RetryableStatusCodes.
+func (p *RetryPolicy) isRetryableStatus(code int) bool {
+	for _, c := range p.RetryableStatusCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the delay before the given retry, starting from 1.
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < retry && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+
+	if p.Jitter > 0 {
+		delta := float64(delay) * p.Jitter
+		delay += time.Duration(delta * (2*rand.Float64() - 1))
+	}
+
+	if delay < 0 {
+		delay = 0
+	}
+
+	return delay
+}
+
+// next decides whether the attempt, which produced res and err, should be retried,
+// and returns the delay to wait before the next attempt.
+func (p *RetryPolicy) next(req *http.Request, attempt int, res *Response, err error) (time.Duration, bool) {
+	if p == nil || attempt >= p.MaxAttempts || !p.allows(req.Method) {
+		return 0, false
+	}
+
+	if err == nil {
+		return 0, false
+	}
+
+	if res == nil {
+		isRetryable := p.RetryableError
+		if isRetryable == nil {
+			isRetryable = IsRetryableError
+		}
+		return p.backoff(attempt), isRetryable(err)
+	}
+
+	if !p.isRetryableStatus(res.StatusCode) {
+		return 0, false
+	}
+
+	if p.RespectRetryAfter {
+		if delay, ok := parseRetryAfter(res.Header.Get(headerRetryAfter)); ok {
+			if p.MaxDelay > 0 && delay > p.MaxDelay {
+				return 0, false
+			}
+			return delay, true
+		}
+	}
+
+	return p.backoff(attempt), true
+}
+
+type noRetriesKey struct{}
+
+// withoutRetries returns a context whose requests are sent once, regardless of the
+// client's RetryPolicy, for callers which retry on their own.
+func withoutRetries(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noRetriesKey{}, true)
+}
+
+func retriesDisabled(ctx context.Context) bool {
+	return ctx.Value(noRetriesKey{}) != nil
+}
+
+// rewind prepares req to be sent once again. The body of requests created with
+// NewRequest can always be rewound.
+func rewind(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+
+	if req.GetBody == nil {
+		return nil, errors.New("basecrm: request body can not be rewound for a retry")
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+
+	retry := req.Clone(req.Context())
+	retry.Body = body
+	return retry, nil
+}
+
+// sleep waits for d or until ctx is done, whichever happens first.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,214 @@
+package basecrm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func TestRetryPolicy(t *testing.T) { TestingT(t) }
+
+type RetrySuite struct {
+}
+
+var _ = Suite(&RetrySuite{})
+
+func testRetryPolicy() *RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.BaseDelay = time.Millisecond
+	policy.MaxDelay = 10 * time.Millisecond
+	policy.Jitter = 0
+	return policy
+}
+
+func (s *RetrySuite) TestNewClient_RetriesByDefault(c *C) {
+	c.Assert(NewClient(nil).RetryPolicy, DeepEquals, DefaultRetryPolicy())
+}
+
+func (s *RetrySuite) TestRetry_Get(c *C) {
+	setup()
+	defer teardown()
+
+	client.RetryPolicy = testRetryPolicy()
+
+	attempts := 0
+	mux.HandleFunc("/v2/deals/1", func(w http.ResponseWriter, req *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprintf(w, `{"data": {"id": 1}}`)
+	})
+
+	deal, res, err := client.Deals.Get(1)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(deal.Id, Equals, 1)
+	c.Assert(attempts, Equals, 3)
+}
+
+func (s *RetrySuite) TestRetry_GivesUp(c *C) {
+	setup()
+	defer teardown()
+
+	client.RetryPolicy = testRetryPolicy()
+
+	attempts := 0
+	mux.HandleFunc("/v2/deals/1", func(w http.ResponseWriter, req *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	_, res, err := client.Deals.Get(1)
+	c.Assert(err, NotNil)
+	c.Assert(res.Response, HasHttpStatus, http.StatusBadGateway)
+	c.Assert(attempts, Equals, 3)
+}
+
+func (s *RetrySuite) TestRetry_NotRetryableStatus(c *C) {
+	setup()
+	defer teardown()
+
+	client.RetryPolicy = testRetryPolicy()
+
+	attempts := 0
+	mux.HandleFunc("/v2/deals/1", func(w http.ResponseWriter, req *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, _, err := client.Deals.Get(1)
+	c.Assert(err, NotNil)
+	c.Assert(attempts, Equals, 1)
+}
+
+func (s *RetrySuite) TestRetry_Disabled(c *C) {
+	setup()
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc("/v2/deals/1", func(w http.ResponseWriter, req *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, _, err := client.Deals.Get(1)
+	c.Assert(err, NotNil)
+	c.Assert(attempts, Equals, 1)
+}
+
+func (s *RetrySuite) TestRetry_PostIsOptIn(c *C) {
+	setup()
+	defer teardown()
+
+	client.RetryPolicy = testRetryPolicy()
+
+	attempts := 0
+	mux.HandleFunc("/v2/deals", func(w http.ResponseWriter, req *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, _, err := client.Deals.Create(&Deal{Name: "Website redesign"})
+	c.Assert(err, NotNil)
+	c.Assert(attempts, Equals, 1)
+}
+
+func (s *RetrySuite) TestRetry_PostReplaysBody(c *C) {
+	setup()
+	defer teardown()
+
+	client.RetryPolicy = testRetryPolicy()
+	client.RetryPolicy.RetryPost = true
+
+	attempts := 0
+	mux.HandleFunc("/v2/deals", func(w http.ResponseWriter, req *http.Request) {
+		attempts++
+
+		root := new(dealRoot)
+		err := json.NewDecoder(req.Body).Decode(root)
+		c.Assert(err, IsNil)
+		c.Assert(root.Deal.Name, Equals, "Website redesign")
+
+		if attempts == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		fmt.Fprintf(w, `{"data": {"id": 1, "name": "Website redesign"}}`)
+	})
+
+	deal, _, err := client.Deals.Create(&Deal{Name: "Website redesign"})
+	c.Assert(err, IsNil)
+	c.Assert(deal.Id, Equals, 1)
+	c.Assert(attempts, Equals, 2)
+}
+
+func (s *RetrySuite) TestRetryPolicy_RetryAfter(c *C) {
+	policy := testRetryPolicy()
+	policy.MaxDelay = time.Minute
+
+	req, _ := http.NewRequest("GET", "/v2/deals/1", nil)
+	res := &Response{Response: &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{"30"}},
+	}}
+	err := &RateLimitError{ErrorResponse: &ErrorResponse{Response: res.Response}}
+
+	delay, retry := policy.next(req, 1, res, err)
+	c.Assert(retry, Equals, true)
+	c.Assert(delay, Equals, 30*time.Second)
+
+	policy.RespectRetryAfter = false
+	delay, retry = policy.next(req, 1, res, err)
+	c.Assert(retry, Equals, true)
+	c.Assert(delay, Equals, policy.BaseDelay)
+}
+
+func (s *RetrySuite) TestRetry_RetryAfterTooLong(c *C) {
+	setup()
+	defer teardown()
+
+	client.RetryPolicy = testRetryPolicy()
+
+	attempts := 0
+	mux.HandleFunc("/v2/deals/1", func(w http.ResponseWriter, req *http.Request) {
+		attempts++
+		w.Header().Add("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	_, _, err := client.Deals.Get(1)
+	_, ok := err.(*RateLimitError)
+	c.Assert(ok, Equals, true)
+	c.Assert(attempts, Equals, 1)
+}
+
+func (s *RetrySuite) TestRetryPolicy_Backoff(c *C) {
+	policy := &RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+
+	c.Assert(policy.backoff(1), Equals, 100*time.Millisecond)
+	c.Assert(policy.backoff(2), Equals, 200*time.Millisecond)
+	c.Assert(policy.backoff(3), Equals, 400*time.Millisecond)
+	c.Assert(policy.backoff(5), Equals, time.Second)
+
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		d := policy.backoff(1)
+		c.Assert(d >= 50*time.Millisecond && d <= 150*time.Millisecond, Equals, true)
+	}
+}
+
+func (s *RetrySuite) TestIsRetryableError(c *C) {
+	c.Assert(IsRetryableError(nil), Equals, false)
+	c.Assert(IsRetryableError(io.ErrUnexpectedEOF), Equals, true)
+	c.Assert(IsRetryableError(fmt.Errorf("read: %w", syscall.ECONNRESET)), Equals, true)
+	c.Assert(IsRetryableError(errors.New("x509: certificate signed by unknown authority")), Equals, false)
+}
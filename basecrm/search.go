@@ -0,0 +1,342 @@
+package basecrm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// SortOrder is the order of search results.
+type SortOrder string
+
+const (
+	Ascending  SortOrder = "ascending"
+	Descending SortOrder = "descending"
+)
+
+// Filter is a search predicate, built with AllOf, AnyOf, NoneOf and the attribute
+// predicates such as Eq or Between.
+type Filter struct {
+	and       []*Filter
+	or        []*Filter
+	not       *Filter
+	attribute string
+	parameter map[string]interface{}
+}
+
+type filterAttribute struct {
+	Name string `json:"name"`
+}
+
+type filterPredicate struct {
+	Attribute *filterAttribute       `json:"attribute"`
+	Parameter map[string]interface{} `json:"parameter"`
+}
+
+func (f *Filter) MarshalJSON() ([]byte, error) {
+	switch {
+	case f.and != nil:
+		return json.Marshal(map[string]interface{}{"and": f.and})
+	case f.or != nil:
+		return json.Marshal(map[string]interface{}{"or": f.or})
+	case f.not != nil:
+		return json.Marshal(map[string]interface{}{"not": f.not})
+	}
+	return json.Marshal(map[string]interface{}{
+		"filter": &filterPredicate{&filterAttribute{f.attribute}, f.parameter},
+	})
+}
+
+// AllOf matches resources matching all of the filters.
+func AllOf(filters ...*Filter) *Filter {
+	return &Filter{and: append([]*Filter{}, filters...)}
+}
+
+// AnyOf matches resources matching any of the filters.
+func AnyOf(filters ...*Filter) *Filter {
+	return &Filter{or: append([]*Filter{}, filters...)}
+}
+
+// NoneOf matches resources matching none of the filters.
+func NoneOf(filters ...*Filter) *Filter {
+	if len(filters) == 1 {
+		return &Filter{not: filters[0]}
+	}
+	return &Filter{not: AnyOf(filters...)}
+}
+
+func predicate(attribute, parameter string, value interface{}) *Filter {
+	return &Filter{attribute: attribute, parameter: map[string]interface{}{parameter: value}}
+}
+
+// Eq matches resources whose attribute equals value.
+func Eq(attribute string, value interface{}) *Filter {
+	return predicate(attribute, "eq", value)
+}
+
+// In matches resources whose attribute equals any of the values.
+func In(attribute string, values ...interface{}) *Filter {
+	return predicate(attribute, "any", values)
+}
+
+// Contains matches resources whose attribute contains the text.
+func Contains(attribute, text string) *Filter {
+	return predicate(attribute, "contains", text)
+}
+
+// StartsWith matches resources whose attribute starts with the prefix.
+func StartsWith(attribute, prefix string) *Filter {
+	return predicate(attribute, "starts_with", prefix)
+}
+
+// IsNull matches resources whose attribute is not set.
+func IsNull(attribute string) *Filter {
+	return predicate(attribute, "is_null", true)
+}
+
+// IsNotNull matches resources whose attribute is set.
+func IsNotNull(attribute string) *Filter {
+	return predicate(attribute, "is_null", false)
+}
+
+// Gt matches resources whose attribute is greater than value.
+// Values can be numbers or time.Time for timestamps such as created_at.
+func Gt(attribute string, value interface{}) *Filter {
+	return predicate(attribute, "range", map[string]interface{}{"gt": value})
+}
+
+// Gte matches resources whose attribute is greater than or equal to value.
+func Gte(attribute string, value interface{}) *Filter {
+	return predicate(attribute, "range", map[string]interface{}{"gte": value})
+}
+
+// Lt matches resources whose attribute is less than value.
+func Lt(attribute string, value interface{}) *Filter {
+	return predicate(attribute, "range", map[string]interface{}{"lt": value})
+}
+
+// Lte matches resources whose attribute is less than or equal to value.
+func Lte(attribute string, value interface{}) *Filter {
+	return predicate(attribute, "range", map[string]interface{}{"lte": value})
+}
+
+// Between matches resources whose attribute is within the inclusive range.
+func Between(attribute string, from, to interface{}) *Filter {
+	return predicate(attribute, "range", map[string]interface{}{"gte": from, "lte": to})
+}
+
+// CustomFieldAttribute returns the search attribute of the custom field with the given name,
+// to be used with the predicates, e.g. Eq(CustomFieldAttribute("industry"), "IT").
+func CustomFieldAttribute(name string) string {
+	return "custom_fields." + name
+}
+
+type searchProjection struct {
+	Name string `json:"name"`
+}
+
+type searchSort struct {
+	Attribute *filterAttribute `json:"attribute"`
+	Order     SortOrder        `json:"order"`
+}
+
+// SearchQuery is a query to the search API. The zero value matches all resources.
+//
+//	q := new(basecrm.SearchQuery).
+//	  Where(basecrm.AllOf(basecrm.Eq("owner_id", 1), basecrm.Gte("value", 1000))).
+//	  Select("id", "name", "value").
+//	  SortBy("created_at", basecrm.Descending)
+type SearchQuery struct {
+	filter     *Filter
+	projection []*searchProjection
+	sort       []*searchSort
+	perPage    int
+	cursor     string
+}
+
+// Where sets the filter of the query.
+func (q *SearchQuery) Where(filter *Filter) *SearchQuery {
+	q.filter = filter
+	return q
+}
+
+// Select limits the attributes returned for every hit. By default all attributes are returned.
+func (q *SearchQuery) Select(attributes ...string) *SearchQuery {
+	for _, attribute := range attributes {
+		q.projection = append(q.projection, &searchProjection{attribute})
+	}
+	return q
+}
+
+// SortBy appends a sort order. Results are sorted by the attributes in the order they were added.
+func (q *SearchQuery) SortBy(attribute string, order SortOrder) *SearchQuery {
+	q.sort = append(q.sort, &searchSort{&filterAttribute{attribute}, order})
+	return q
+}
+
+// PerPage sets the number of hits returned per page.
+func (q *SearchQuery) PerPage(perPage int) *SearchQuery {
+	q.perPage = perPage
+	return q
+}
+
+// Cursor sets the cursor of the page to return, as returned in SearchResult.NextCursor.
+func (q *SearchQuery) Cursor(cursor string) *SearchQuery {
+	q.cursor = cursor
+	return q
+}
+
+type searchRequestData struct {
+	Query struct {
+		Projection []*searchProjection `json:"projection,omitempty"`
+		Filter     *Filter             `json:"filter,omitempty"`
+		Sort       []*searchSort       `json:"sort,omitempty"`
+	} `json:"query"`
+	Cursor  string `json:"cursor,omitempty"`
+	PerPage int    `json:"per_page,omitempty"`
+}
+
+type searchRequest struct {
+	Items []*struct {
+		Data *searchRequestData `json:"data"`
+	} `json:"items"`
+}
+
+func (q *SearchQuery) request() *searchRequest {
+	data := new(searchRequestData)
+	if q != nil {
+		data.Query.Projection = q.projection
+		data.Query.Filter = q.filter
+		data.Query.Sort = q.sort
+		data.Cursor = q.cursor
+		data.PerPage = q.perPage
+	}
+
+	return &searchRequest{Items: []*struct {
+		Data *searchRequestData `json:"data"`
+	}{{data}}}
+}
+
+// SearchResult is a page of search hits.
+type SearchResult struct {
+	// Items holds the decoded hits, e.g. *Deal or *Contact.
+	Items      []interface{}
+	Count      int
+	TotalCount int
+	// Cursor of the next page, empty on the last page.
+	NextCursor string
+}
+
+// Deals returns the hits which are deals.
+func (r *SearchResult) Deals() []*Deal {
+	return searchHits[*Deal](r)
+}
+
+// Contacts returns the hits which are contacts.
+func (r *SearchResult) Contacts() []*Contact {
+	return searchHits[*Contact](r)
+}
+
+// Leads returns the hits which are leads.
+func (r *SearchResult) Leads() []*Lead {
+	return searchHits[*Lead](r)
+}
+
+func searchHits[T any](r *SearchResult) []T {
+	hits := make([]T, 0, len(r.Items))
+	for _, item := range r.Items {
+		if hit, ok := item.(T); ok {
+			hits = append(hits, hit)
+		}
+	}
+	return hits
+}
+
+type searchRoot struct {
+	Items []*struct {
+		Items []*struct {
+			Data json.RawMessage `json:"data"`
+			Meta *struct {
+				Type string `json:"type"`
+			} `json:"meta"`
+		} `json:"items"`
+		Meta *struct {
+			Count      int `json:"count"`
+			TotalCount int `json:"total_count"`
+			Links      *struct {
+				NextPage string `json:"next_page"`
+			} `json:"links"`
+		} `json:"meta"`
+		Successful bool `json:"successful"`
+	} `json:"items"`
+}
+
+type SearchService interface {
+	Search(resourceType ResourceType, q *SearchQuery) (*SearchResult, *Response, error)
+	SearchContext(ctx context.Context, resourceType ResourceType, q *SearchQuery) (*SearchResult, *Response, error)
+}
+
+func NewSearchService(client *Client) SearchService {
+	return &SearchServiceOp{client}
+}
+
+type SearchServiceOp struct {
+	client *Client
+}
+
+// Search searches resources of the type, e.g. DealResource, matching the query.
+func (s *SearchServiceOp) Search(resourceType ResourceType, q *SearchQuery) (*SearchResult, *Response, error) {
+	return s.SearchContext(context.Background(), resourceType, q)
+}
+
+func (s *SearchServiceOp) SearchContext(ctx context.Context, resourceType ResourceType, q *SearchQuery) (*SearchResult, *Response, error) {
+	u := fmt.Sprintf("/v3/%ss/search", resourceType)
+	req, err := s.client.NewRequestContext(ctx, "POST", u, q.request())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(searchRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	if len(root.Items) == 0 {
+		return nil, res, errors.New("basecrm: empty search response")
+	}
+
+	page := root.Items[0]
+	if !page.Successful {
+		return nil, res, errors.New("basecrm: search was not successful")
+	}
+
+	result := &SearchResult{Items: make([]interface{}, 0, len(page.Items))}
+	if page.Meta != nil {
+		result.Count = page.Meta.Count
+		result.TotalCount = page.Meta.TotalCount
+		if page.Meta.Links != nil {
+			result.NextCursor = page.Meta.Links.NextPage
+		}
+	}
+
+	for _, hit := range page.Items {
+		if hit == nil {
+			continue
+		}
+
+		hitType := string(resourceType)
+		if hit.Meta != nil && hit.Meta.Type != "" {
+			hitType = hit.Meta.Type
+		}
+
+		item, err := DecodeResource(hitType, hit.Data)
+		if err != nil {
+			return nil, res, err
+		}
+		result.Items = append(result.Items, item)
+	}
+
+	return result, res, nil
+}
@@ -0,0 +1,154 @@
+package basecrm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func TestSearchService(t *testing.T) { TestingT(t) }
+
+type SearchSuite struct {
+}
+
+var _ = Suite(&SearchSuite{})
+
+func (s *SearchSuite) TestFilter_MarshalJSON(c *C) {
+	since := time.Date(2014, 8, 1, 0, 0, 0, 0, time.UTC)
+	filter := AllOf(
+		Eq("owner_id", 1),
+		AnyOf(Between("value", 100, 1000), Gte("created_at", since)),
+		NoneOf(In(CustomFieldAttribute("industry"), "IT", "Retail")),
+		IsNull("source_id"),
+	)
+
+	data, err := json.Marshal(filter)
+	c.Assert(err, IsNil)
+
+	expected := `{"and":[
+    {"filter":{"attribute":{"name":"owner_id"},"parameter":{"eq":1}}},
+    {"or":[
+      {"filter":{"attribute":{"name":"value"},"parameter":{"range":{"gte":100,"lte":1000}}}},
+      {"filter":{"attribute":{"name":"created_at"},"parameter":{"range":{"gte":"2014-08-01T00:00:00Z"}}}}]},
+    {"not":{"filter":{"attribute":{"name":"custom_fields.industry"},"parameter":{"any":["IT","Retail"]}}}},
+    {"filter":{"attribute":{"name":"source_id"},"parameter":{"is_null":true}}}]}`
+
+	var actual, want interface{}
+	c.Assert(json.Unmarshal(data, &actual), IsNil)
+	c.Assert(json.Unmarshal([]byte(expected), &want), IsNil)
+	c.Assert(actual, DeepEquals, want)
+}
+
+func (s *SearchSuite) TestSearchService_Search(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v3/deals/search", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req, HasHttpMethod, "POST")
+
+		var body interface{}
+		json.NewDecoder(req.Body).Decode(&body)
+
+		var expected interface{}
+		json.Unmarshal([]byte(`{"items": [{"data": {
+      "query": {
+        "projection": [{"name": "id"}, {"name": "name"}],
+        "filter": {"filter": {"attribute": {"name": "name"}, "parameter": {"starts_with": "Website"}}},
+        "sort": [{"attribute": {"name": "value"}, "order": "descending"}]
+      },
+      "cursor": "abc",
+      "per_page": 2
+    }}]}`), &expected)
+		c.Assert(body, DeepEquals, expected)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "items": [{
+        "items": [{
+          "data": {
+            "id": 1,
+            "name": "Website Redesign"
+          },
+          "meta": {
+            "type": "deal"
+          }
+        }, {
+          "data": {
+            "id": 2,
+            "name": "Website Hosting"
+          },
+          "meta": {
+            "type": "deal"
+          }
+        }],
+        "meta": {
+          "count": 2,
+          "total_count": 5,
+          "links": {
+            "next_page": "def"
+          }
+        },
+        "successful": true
+      }]
+    }
+    `
+		fmt.Fprint(w, jsonBlob)
+	})
+
+	q := new(SearchQuery).
+		Where(StartsWith("name", "Website")).
+		Select("id", "name").
+		SortBy("value", Descending).
+		PerPage(2).
+		Cursor("abc")
+
+	result, _, err := client.Search.Search(DealResource, q)
+	c.Assert(err, IsNil)
+	c.Assert(result.Count, Equals, 2)
+	c.Assert(result.TotalCount, Equals, 5)
+	c.Assert(result.NextCursor, Equals, "def")
+	c.Assert(result.Items, HasLen, 2)
+
+	deals := result.Deals()
+	c.Assert(deals, HasLen, 2)
+	c.Assert(deals[1].Name, Equals, "Website Hosting")
+	c.Assert(result.Contacts(), HasLen, 0)
+}
+
+func (s *SearchSuite) TestSearchService_Search_All(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v3/contacts/search", func(w http.ResponseWriter, req *http.Request) {
+		var body interface{}
+		json.NewDecoder(req.Body).Decode(&body)
+		c.Assert(body, DeepEquals, map[string]interface{}{
+			"items": []interface{}{map[string]interface{}{"data": map[string]interface{}{"query": map[string]interface{}{}}}},
+		})
+
+		fmt.Fprint(w, `{"items": [{"items": [{"data": {"id": 1, "first_name": "Mark"}}], "meta": {"count": 1, "total_count": 1}, "successful": true}]}`)
+	})
+
+	result, _, err := client.Search.Search(ContactResource, nil)
+	c.Assert(err, IsNil)
+	c.Assert(result.NextCursor, Equals, "")
+	c.Assert(result.Contacts()[0].FirstName, Equals, "Mark")
+}
+
+func (s *SearchSuite) TestSearchService_Search_Unsuccessful(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v3/leads/search", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"items": [{"items": [], "successful": false}]}`)
+	})
+
+	_, _, err := client.Search.Search(LeadResource, nil)
+	c.Assert(err, ErrorMatches, ".*not successful")
+}
@@ -1,17 +1,29 @@
 package basecrm
 
 import (
+	"context"
 	"fmt"
 	"net/http"
-	"time"
 )
 
+// Source is a generic source shared by leads and deals. Use LeadSourcesService
+// or DealSourcesService to manage sources of a single resource type.
 type Source struct {
-	Id        int       `json:"id,omitempty"`
-	CreatorId int       `json:"creator_id,omitempty"`
-	Name      string    `json:"name,omitempty"`
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
-	CreatedAt time.Time `json:"created_at,omitempty"`
+	Id           int          `json:"id,omitempty"`
+	CreatorId    int          `json:"creator_id,omitempty"`
+	Name         string       `json:"name,omitempty"`
+	ResourceType ResourceType `json:"resource_type,omitempty"`
+	UpdatedAt    Timestamp    `json:"updated_at,omitempty"`
+	CreatedAt    Timestamp    `json:"created_at,omitempty"`
+
+	ForceSendFields []string `json:"-"`
+	NullFields      []string `json:"-"`
+}
+
+// MarshalJSON encodes the Source, sending the fields listed in ForceSendFields and NullFields.
+func (s Source) MarshalJSON() ([]byte, error) {
+	type source Source
+	return marshalFields(source(s), s.ForceSendFields, s.NullFields)
 }
 
 type SourceListOptions struct {
@@ -23,10 +35,17 @@ type SourceListOptions struct {
 
 type SourcesService interface {
 	List(opt *SourceListOptions) ([]*Source, *Response, error)
+	ListContext(ctx context.Context, opt *SourceListOptions) ([]*Source, *Response, error)
+	ListAll(opt *SourceListOptions) *Iter[*Source]
+	ListAllContext(ctx context.Context, opt *SourceListOptions) *Iter[*Source]
 	Get(id int) (*Source, *Response, error)
+	GetContext(ctx context.Context, id int) (*Source, *Response, error)
 	Create(source *Source) (*Source, *Response, error)
+	CreateContext(ctx context.Context, source *Source) (*Source, *Response, error)
 	Edit(id int, source *Source) (*Source, *Response, error)
+	EditContext(ctx context.Context, id int, source *Source) (*Source, *Response, error)
 	Delete(id int) (bool, *Response, error)
+	DeleteContext(ctx context.Context, id int) (bool, *Response, error)
 }
 
 func NewSourcesService(client *Client) SourcesService {
@@ -58,12 +77,16 @@ type SourcesServiceOp struct {
 }
 
 func (s *SourcesServiceOp) List(opt *SourceListOptions) ([]*Source, *Response, error) {
+	return s.ListContext(context.Background(), opt)
+}
+
+func (s *SourcesServiceOp) ListContext(ctx context.Context, opt *SourceListOptions) ([]*Source, *Response, error) {
 	u, err := addOptions("/v2/sources", opt)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -74,12 +97,34 @@ func (s *SourcesServiceOp) List(opt *SourceListOptions) ([]*Source, *Response, e
 		return nil, res, err
 	}
 
+	res.setMeta(root.Meta)
+
 	return root.Sources(), res, err
 }
 
+func (s *SourcesServiceOp) ListAll(opt *SourceListOptions) *Iter[*Source] {
+	return s.ListAllContext(context.Background(), opt)
+}
+
+func (s *SourcesServiceOp) ListAllContext(ctx context.Context, opt *SourceListOptions) *Iter[*Source] {
+	o := SourceListOptions{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIter(ctx, o.Page, func(ctx context.Context, page int) ([]*Source, *Response, error) {
+		o.Page = page
+		return s.ListContext(ctx, &o)
+	})
+}
+
 func (s *SourcesServiceOp) Get(id int) (*Source, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+func (s *SourcesServiceOp) GetContext(ctx context.Context, id int) (*Source, *Response, error) {
 	u := fmt.Sprintf("/v2/sources/%d", id)
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -94,9 +139,13 @@ func (s *SourcesServiceOp) Get(id int) (*Source, *Response, error) {
 }
 
 func (s *SourcesServiceOp) Create(source *Source) (*Source, *Response, error) {
+	return s.CreateContext(context.Background(), source)
+}
+
+func (s *SourcesServiceOp) CreateContext(ctx context.Context, source *Source) (*Source, *Response, error) {
 	u := "/v2/sources"
 	envelope := &sourceRoot{Source: source}
-	req, err := s.client.NewRequest("POST", u, envelope)
+	req, err := s.client.NewRequestContext(ctx, "POST", u, envelope)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -111,9 +160,13 @@ func (s *SourcesServiceOp) Create(source *Source) (*Source, *Response, error) {
 }
 
 func (s *SourcesServiceOp) Edit(id int, source *Source) (*Source, *Response, error) {
+	return s.EditContext(context.Background(), id, source)
+}
+
+func (s *SourcesServiceOp) EditContext(ctx context.Context, id int, source *Source) (*Source, *Response, error) {
 	u := fmt.Sprintf("/v2/sources/%d", id)
 	envelope := &sourceRoot{Source: source}
-	req, err := s.client.NewRequest("PUT", u, envelope)
+	req, err := s.client.NewRequestContext(ctx, "PUT", u, envelope)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -128,8 +181,12 @@ func (s *SourcesServiceOp) Edit(id int, source *Source) (*Source, *Response, err
 }
 
 func (s *SourcesServiceOp) Delete(id int) (bool, *Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+func (s *SourcesServiceOp) DeleteContext(ctx context.Context, id int) (bool, *Response, error) {
 	u := fmt.Sprintf("/v2/sources/%d", id)
-	req, err := s.client.NewRequest("DELETE", u, nil)
+	req, err := s.client.NewRequestContext(ctx, "DELETE", u, nil)
 	if err != nil {
 		return false, nil, err
 	}
@@ -0,0 +1,142 @@
+package basecrm
+
+import (
+	"context"
+	"fmt"
+)
+
+type StageCategory string
+
+const (
+	IncomingStage    StageCategory = "incoming"
+	InProgressStage  StageCategory = "in_progress"
+	WonStage         StageCategory = "won"
+	LostStage        StageCategory = "lost"
+	UnqualifiedStage StageCategory = "unqualified"
+)
+
+type Stage struct {
+	Id         int           `json:"id,omitempty"`
+	PipelineId int           `json:"pipeline_id,omitempty"`
+	Name       string        `json:"name,omitempty"`
+	Category   StageCategory `json:"category,omitempty"`
+	Position   int           `json:"position,omitempty"`
+	Likelihood int           `json:"likelihood,omitempty"`
+	Active     bool          `json:"active,omitempty"`
+	UpdatedAt  Timestamp     `json:"updated_at,omitempty"`
+	CreatedAt  Timestamp     `json:"created_at,omitempty"`
+}
+
+// MarshalJSON encodes the Stage, omitting its unset timestamps.
+func (s Stage) MarshalJSON() ([]byte, error) {
+	type stage Stage
+	return marshalFields(stage(s), nil, nil)
+}
+
+type StageListOptions struct {
+	Name string `url:"name,omitempty"`
+
+	PipelineId int   `url:"pipeline_id,omitempty"`
+	Active     *bool `url:"active,omitempty"`
+
+	ListOptions
+}
+
+type StagesService interface {
+	List(opt *StageListOptions) ([]*Stage, *Response, error)
+	ListContext(ctx context.Context, opt *StageListOptions) ([]*Stage, *Response, error)
+	ListAll(opt *StageListOptions) *Iter[*Stage]
+	ListAllContext(ctx context.Context, opt *StageListOptions) *Iter[*Stage]
+	Get(id int) (*Stage, *Response, error)
+	GetContext(ctx context.Context, id int) (*Stage, *Response, error)
+}
+
+func NewStagesService(client *Client) StagesService {
+	return &StagesServiceOp{client}
+}
+
+type stageRoot struct {
+	Stage *Stage `json:"data"`
+	Meta  *struct {
+		Type string `json:"type,omitempty"`
+	} `json:"meta,omitempty"`
+}
+
+type stagesRoot struct {
+	Items []*stageRoot `json:"items"`
+	Meta  *Meta        `json:"meta"`
+}
+
+func (r *stagesRoot) Stages() []*Stage {
+	stages := make([]*Stage, len(r.Items))
+	for i, root := range r.Items {
+		stages[i] = root.Stage
+	}
+	return stages
+}
+
+type StagesServiceOp struct {
+	client *Client
+}
+
+func (s *StagesServiceOp) List(opt *StageListOptions) ([]*Stage, *Response, error) {
+	return s.ListContext(context.Background(), opt)
+}
+
+func (s *StagesServiceOp) ListContext(ctx context.Context, opt *StageListOptions) ([]*Stage, *Response, error) {
+	u, err := addOptions("/v2/stages", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(stagesRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	res.setMeta(root.Meta)
+
+	return root.Stages(), res, err
+}
+
+func (s *StagesServiceOp) ListAll(opt *StageListOptions) *Iter[*Stage] {
+	return s.ListAllContext(context.Background(), opt)
+}
+
+func (s *StagesServiceOp) ListAllContext(ctx context.Context, opt *StageListOptions) *Iter[*Stage] {
+	o := StageListOptions{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIter(ctx, o.Page, func(ctx context.Context, page int) ([]*Stage, *Response, error) {
+		o.Page = page
+		return s.ListContext(ctx, &o)
+	})
+}
+
+func (s *StagesServiceOp) Get(id int) (*Stage, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+func (s *StagesServiceOp) GetContext(ctx context.Context, id int) (*Stage, *Response, error) {
+	u := fmt.Sprintf("/v2/stages/%d", id)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(stageRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return root.Stage, res, err
+}
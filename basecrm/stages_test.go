@@ -0,0 +1,143 @@
+package basecrm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+func TestStagesService(t *testing.T) { TestingT(t) }
+
+type StagesSuite struct {
+}
+
+var _ = Suite(&StagesSuite{})
+
+func (s *StagesSuite) TestStagesService_List_All(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/stages", func(w http.ResponseWriter, req *http.Request) {
+		expected := map[string]string{
+			"name":        "Qualified",
+			"pipeline_id": "1",
+			"active":      "true",
+			"page":        "1",
+			"per_page":    "25",
+			"ids":         "1,2,3",
+			"sort_by":     "name:desc,created_at:asc",
+		}
+		c.Assert(req, HasHttpMethod, "GET")
+		c.Assert(req, HasHttpHeader, "Accept", "application/json")
+		c.Assert(req, HasQueryParams, expected)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+     {
+      "items": [{
+          "data": {
+            "id": 1
+          },
+          "meta": {
+            "type": "stage"
+          }
+      }, {
+          "data": {
+            "id": 2
+          },
+          "meta": {
+            "type": "stage"
+          }
+      }],
+      "meta": {
+        "type": "collection",
+        "count": 2,
+        "links": {
+          "self": "http://api.getbase.com/v2/stages.json"
+        }
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	opt := &StageListOptions{
+		Name:       "Qualified",
+		PipelineId: 1,
+		Active:     Bool(true),
+		ListOptions: ListOptions{
+			Page:    1,
+			PerPage: 25,
+			Ids:     []int{1, 2, 3},
+			SortBy:  []string{"name:desc", "created_at:asc"},
+		},
+	}
+	stages, res, err := client.Stages.List(opt)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(stages, NotNil)
+
+	c.Assert(len(stages), Equals, 2)
+	c.Assert(stages[0].Id, Equals, 1)
+	c.Assert(stages[1].Id, Equals, 2)
+}
+
+func (s *StagesSuite) TestStagesService_List_Inactive(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/stages", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req, HasQueryParams, map[string]string{"active": "false"})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"items": [], "meta": {"type": "collection", "count": 0}}`)
+	})
+
+	_, _, err := client.Stages.List(&StageListOptions{Active: Bool(false)})
+	c.Assert(err, IsNil)
+}
+
+func (s *StagesSuite) TestStagesService_Get(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/stages/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "pipeline_id": 1,
+        "name": "Qualified",
+        "category": "in_progress",
+        "position": 2,
+        "likelihood": 30,
+        "active": true,
+        "created_at": "2014-08-27T16:32:56Z",
+        "updated_at": "2014-08-27T16:32:56Z"
+      },
+      "meta": {
+        "type": "stage"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	stage, res, err := client.Stages.Get(1)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(stage, NotNil)
+
+	c.Assert(stage.Id, Equals, 1)
+	c.Assert(stage.PipelineId, Equals, 1)
+	c.Assert(stage.Category, Equals, InProgressStage)
+	c.Assert(stage.Likelihood, Equals, 30)
+	c.Assert(stage.Active, Equals, true)
+}
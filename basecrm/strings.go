@@ -7,6 +7,11 @@ import (
 	"reflect"
 )
 
+var (
+	timestampType = reflect.TypeOf(Timestamp{})
+	dateType      = reflect.TypeOf(Date{})
+)
+
 // Stringify attempts to create a string representation of BaseCRM types
 func Stringify(message interface{}) string {
 	var buf bytes.Buffer
@@ -40,6 +45,12 @@ func stringifyValue(w io.Writer, val reflect.Value) {
 		w.Write([]byte{']'})
 		return
 	case reflect.Struct:
+		// timestamps and dates are printed like their String method
+		if v.Type() == timestampType || v.Type() == dateType {
+			fmt.Fprintf(w, `"%s"`, v.Interface())
+			return
+		}
+
 		if v.Type().Name() != "" {
 			w.Write([]byte(v.Type().String()))
 		}
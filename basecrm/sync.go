@@ -0,0 +1,319 @@
+package basecrm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+const headerDeviceUUID = "X-Basecrm-Device-UUID"
+
+// Sync event types.
+const (
+	SyncCreated = "created"
+	SyncUpdated = "updated"
+	SyncDeleted = "deleted"
+)
+
+// SyncSession is a snapshot of changes prepared by the Sync API for a device.
+type SyncSession struct {
+	Id     string       `json:"id"`
+	Queues []*SyncQueue `json:"-"`
+}
+
+type SyncQueue struct {
+	Name       string `json:"name"`
+	Pages      int    `json:"pages"`
+	TotalCount int    `json:"total_count"`
+}
+
+// SyncMeta describes a single change delivered by the Sync API.
+type SyncMeta struct {
+	// Type of the resource, e.g. deal, contact or lead.
+	Type string `json:"type"`
+	// One of SyncCreated, SyncUpdated or SyncDeleted.
+	EventType string `json:"event_type"`
+	// Key used to acknowledge the item.
+	AckKey   string `json:"ack_key"`
+	Revision int    `json:"revision"`
+}
+
+// SyncItem is a single change fetched from a sync queue. Resource holds the
+// decoded model, e.g. *Deal or *Contact, or a map[string]interface{} for unknown types.
+type SyncItem struct {
+	Meta     *SyncMeta
+	Resource interface{}
+	Data     json.RawMessage
+}
+
+// syncModels maps resource types to constructors of their models.
+var syncModels = map[string]func() interface{}{
+	"account":                 func() interface{} { return new(Account) },
+	"user":                    func() interface{} { return new(User) },
+	"contact":                 func() interface{} { return new(Contact) },
+	"lead":                    func() interface{} { return new(Lead) },
+	"deal":                    func() interface{} { return new(Deal) },
+	"note":                    func() interface{} { return new(Note) },
+	"task":                    func() interface{} { return new(Task) },
+	"tag":                     func() interface{} { return new(Tag) },
+	"source":                  func() interface{} { return new(Source) },
+	"lead_source":             func() interface{} { return new(LeadSource) },
+	"deal_source":             func() interface{} { return new(DealSource) },
+	"loss_reason":             func() interface{} { return new(LossReason) },
+	"lead_unqualified_reason": func() interface{} { return new(LeadUnqualifiedReason) },
+	"deal_unqualified_reason": func() interface{} { return new(DealUnqualifiedReason) },
+	"pipeline":                func() interface{} { return new(Pipeline) },
+	"stage":                   func() interface{} { return new(Stage) },
+	"product":                 func() interface{} { return new(Product) },
+	"order":                   func() interface{} { return new(Order) },
+	"line_item":               func() interface{} { return new(LineItem) },
+	"call":                    func() interface{} { return new(Call) },
+	"call_outcome":            func() interface{} { return new(CallOutcome) },
+	"text_message":            func() interface{} { return new(TextMessage) },
+	"visit":                   func() interface{} { return new(Visit) },
+	"visit_outcome":           func() interface{} { return new(VisitOutcome) },
+	"lead_conversion":         func() interface{} { return new(LeadConversion) },
+	"collaboration":           func() interface{} { return new(Collaboration) },
+	"associated_contact":      func() interface{} { return new(AssociatedContact) },
+	"custom_field":            func() interface{} { return new(CustomField) },
+}
+
+// DecodeResource decodes data into the model matching the resource type, e.g. *Deal
+// for deals, or into a map[string]interface{} for unknown types. It returns nil when
+// there is no data, e.g. for some deleted resources.
+func DecodeResource(resourceType string, data json.RawMessage) (interface{}, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+
+	var v interface{}
+	if model, ok := syncModels[resourceType]; ok {
+		v = model()
+	} else {
+		v = &map[string]interface{}{}
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, fmt.Errorf("basecrm: decoding %s: %w", resourceType, err)
+	}
+
+	if m, ok := v.(*map[string]interface{}); ok {
+		return *m, nil
+	}
+	return v, nil
+}
+
+type SyncService interface {
+	Start(deviceUUID string) (*SyncSession, *Response, error)
+	StartContext(ctx context.Context, deviceUUID string) (*SyncSession, *Response, error)
+	Fetch(deviceUUID, sessionId, queue string) ([]*SyncItem, *Response, error)
+	FetchContext(ctx context.Context, deviceUUID, sessionId, queue string) ([]*SyncItem, *Response, error)
+	Ack(deviceUUID string, ackKeys []string) (bool, *Response, error)
+	AckContext(ctx context.Context, deviceUUID string, ackKeys []string) (bool, *Response, error)
+}
+
+func NewSyncService(client *Client) SyncService {
+	return &SyncServiceOp{client}
+}
+
+type syncSessionRoot struct {
+	Session *struct {
+		Id     string `json:"id"`
+		Queues []*struct {
+			Queue *SyncQueue `json:"data"`
+		} `json:"queues"`
+	} `json:"data"`
+}
+
+type syncItemsRoot struct {
+	Items []*struct {
+		Data json.RawMessage `json:"data"`
+		Meta *struct {
+			Type string    `json:"type"`
+			Sync *SyncMeta `json:"sync"`
+		} `json:"meta"`
+	} `json:"items"`
+	Meta *Meta `json:"meta"`
+}
+
+type syncAckRoot struct {
+	Data *struct {
+		AckKeys []string `json:"ack_keys"`
+	} `json:"data"`
+}
+
+type SyncServiceOp struct {
+	client *Client
+}
+
+// Start starts a new synchronization session. It returns a nil session when
+// there is nothing to synchronize.
+func (s *SyncServiceOp) Start(deviceUUID string) (*SyncSession, *Response, error) {
+	return s.StartContext(context.Background(), deviceUUID)
+}
+
+func (s *SyncServiceOp) StartContext(ctx context.Context, deviceUUID string) (*SyncSession, *Response, error) {
+	u := "/v2/sync/start"
+	req, err := s.client.NewRequestContext(ctx, "POST", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set(headerDeviceUUID, deviceUUID)
+
+	root := new(syncSessionRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	if res.StatusCode == http.StatusNoContent || root.Session == nil {
+		return nil, res, nil
+	}
+
+	session := &SyncSession{Id: root.Session.Id}
+	for _, q := range root.Session.Queues {
+		if q != nil && q.Queue != nil {
+			session.Queues = append(session.Queues, q.Queue)
+		}
+	}
+
+	return session, res, err
+}
+
+// Fetch fetches the next batch of items from the queue. It returns no items
+// once the queue has been drained.
+func (s *SyncServiceOp) Fetch(deviceUUID, sessionId, queue string) ([]*SyncItem, *Response, error) {
+	return s.FetchContext(context.Background(), deviceUUID, sessionId, queue)
+}
+
+func (s *SyncServiceOp) FetchContext(ctx context.Context, deviceUUID, sessionId, queue string) ([]*SyncItem, *Response, error) {
+	u := fmt.Sprintf("/v2/sync/%s/queues/%s", sessionId, queue)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set(headerDeviceUUID, deviceUUID)
+
+	root := new(syncItemsRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	res.setMeta(root.Meta)
+
+	items := make([]*SyncItem, 0, len(root.Items))
+	for _, raw := range root.Items {
+		if raw == nil || raw.Meta == nil || raw.Meta.Sync == nil {
+			continue
+		}
+
+		meta := raw.Meta.Sync
+		meta.Type = raw.Meta.Type
+
+		resource, err := DecodeResource(meta.Type, raw.Data)
+		if err != nil {
+			return nil, res, err
+		}
+
+		items = append(items, &SyncItem{Meta: meta, Resource: resource, Data: raw.Data})
+	}
+
+	return items, res, err
+}
+
+// Ack acknowledges items, so they are not delivered again.
+func (s *SyncServiceOp) Ack(deviceUUID string, ackKeys []string) (bool, *Response, error) {
+	return s.AckContext(context.Background(), deviceUUID, ackKeys)
+}
+
+func (s *SyncServiceOp) AckContext(ctx context.Context, deviceUUID string, ackKeys []string) (bool, *Response, error) {
+	u := "/v2/sync/ack"
+	envelope := &syncAckRoot{}
+	envelope.Data = &struct {
+		AckKeys []string `json:"ack_keys"`
+	}{ackKeys}
+
+	req, err := s.client.NewRequestContext(ctx, "POST", u, envelope)
+	if err != nil {
+		return false, nil, err
+	}
+	req.Header.Set(headerDeviceUUID, deviceUUID)
+
+	res, err := s.client.Do(req, nil)
+	if err != nil {
+		return false, res, err
+	}
+
+	return res.StatusCode == http.StatusAccepted, res, err
+}
+
+// SyncHandler is called for every fetched item. Returning true acknowledges the item,
+// returning false leaves it in the queue to be delivered again in a later session.
+type SyncHandler func(item *SyncItem) bool
+
+// Sync implements the high-level synchronization flow: it starts a session,
+// drains all of its queues passing each item to a handler, and acknowledges the handled items.
+type Sync struct {
+	client     *Client
+	deviceUUID string
+}
+
+// NewSync returns a Sync for the device. The device UUID must be stable between
+// runs, the API tracks which changes were delivered to each device.
+func NewSync(client *Client, deviceUUID string) *Sync {
+	return &Sync{client: client, deviceUUID: deviceUUID}
+}
+
+// Fetch runs a single synchronization session.
+func (s *Sync) Fetch(handler SyncHandler) error {
+	return s.FetchContext(context.Background(), handler)
+}
+
+func (s *Sync) FetchContext(ctx context.Context, handler SyncHandler) error {
+	if s.deviceUUID == "" {
+		return errors.New("basecrm: sync requires a device UUID")
+	}
+
+	session, _, err := s.client.Sync.StartContext(ctx, s.deviceUUID)
+	if err != nil {
+		return err
+	}
+	if session == nil {
+		return nil
+	}
+
+	queues := session.Queues
+	if len(queues) == 0 {
+		queues = []*SyncQueue{{Name: "main"}}
+	}
+
+	for _, queue := range queues {
+		for {
+			items, _, err := s.client.Sync.FetchContext(ctx, s.deviceUUID, session.Id, queue.Name)
+			if err != nil {
+				return err
+			}
+			if len(items) == 0 {
+				break
+			}
+
+			var ackKeys []string
+			for _, item := range items {
+				if handler(item) {
+					ackKeys = append(ackKeys, item.Meta.AckKey)
+				}
+			}
+
+			if len(ackKeys) > 0 {
+				if _, _, err := s.client.Sync.AckContext(ctx, s.deviceUUID, ackKeys); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
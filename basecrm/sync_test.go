@@ -0,0 +1,260 @@
+package basecrm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+func TestSyncService(t *testing.T) { TestingT(t) }
+
+type SyncSuite struct {
+}
+
+var _ = Suite(&SyncSuite{})
+
+const syncDeviceUUID = "6dadcec8-6e61-4691-b17f-1a4c20c1c9f3"
+
+func (s *SyncSuite) TestSyncService_Start(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/sync/start", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req, HasHttpMethod, "POST")
+		c.Assert(req, HasHttpHeader, "X-Basecrm-Device-UUID", syncDeviceUUID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": "29f2ed38-e3b1-4ba2-a7b0-8ac4fc95bad4",
+        "queues": [{
+          "data": {
+            "name": "main",
+            "pages": 1,
+            "total_count": 2
+          },
+          "meta": {
+            "type": "sync_queue"
+          }
+        }]
+      },
+      "meta": {
+        "type": "sync_session"
+      }
+    }
+    `
+		fmt.Fprint(w, jsonBlob)
+	})
+
+	session, _, err := client.Sync.Start(syncDeviceUUID)
+	c.Assert(err, IsNil)
+	c.Assert(session.Id, Equals, "29f2ed38-e3b1-4ba2-a7b0-8ac4fc95bad4")
+	c.Assert(session.Queues, DeepEquals, []*SyncQueue{{Name: "main", Pages: 1, TotalCount: 2}})
+}
+
+func (s *SyncSuite) TestSyncService_Start_NothingToSync(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/sync/start", func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	session, res, err := client.Sync.Start(syncDeviceUUID)
+	c.Assert(err, IsNil)
+	c.Assert(session, IsNil)
+	c.Assert(res.Response, HasHttpStatus, http.StatusNoContent)
+}
+
+func (s *SyncSuite) TestSyncService_Fetch(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/sync/session/queues/main", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req, HasHttpMethod, "GET")
+		c.Assert(req, HasHttpHeader, "X-Basecrm-Device-UUID", syncDeviceUUID)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "items": [{
+        "data": {
+          "id": 1,
+          "name": "Website Redesign"
+        },
+        "meta": {
+          "type": "deal",
+          "sync": {
+            "event_type": "created",
+            "ack_key": "Deal-1-1",
+            "revision": 1
+          }
+        }
+      }, {
+        "data": {
+          "id": 2,
+          "first_name": "Mark"
+        },
+        "meta": {
+          "type": "contact",
+          "sync": {
+            "event_type": "updated",
+            "ack_key": "Contact-2-3",
+            "revision": 3
+          }
+        }
+      }, {
+        "data": {
+          "id": 3
+        },
+        "meta": {
+          "type": "unknown_resource",
+          "sync": {
+            "event_type": "deleted",
+            "ack_key": "Unknown-3-2",
+            "revision": 2
+          }
+        }
+      }],
+      "meta": {
+        "type": "collection",
+        "count": 3
+      }
+    }
+    `
+		fmt.Fprint(w, jsonBlob)
+	})
+
+	items, _, err := client.Sync.Fetch(syncDeviceUUID, "session", "main")
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 3)
+
+	c.Assert(items[0].Meta, DeepEquals, &SyncMeta{Type: "deal", EventType: SyncCreated, AckKey: "Deal-1-1", Revision: 1})
+	deal, ok := items[0].Resource.(*Deal)
+	c.Assert(ok, Equals, true)
+	c.Assert(deal.Id, Equals, 1)
+	c.Assert(deal.Name, Equals, "Website Redesign")
+
+	c.Assert(items[1].Meta.EventType, Equals, SyncUpdated)
+	contact, ok := items[1].Resource.(*Contact)
+	c.Assert(ok, Equals, true)
+	c.Assert(contact.FirstName, Equals, "Mark")
+
+	c.Assert(items[2].Meta.EventType, Equals, SyncDeleted)
+	c.Assert(items[2].Resource, DeepEquals, map[string]interface{}{"id": float64(3)})
+}
+
+func (s *SyncSuite) TestDecodeResource(c *C) {
+	deal, err := DecodeResource("deal", json.RawMessage(`{"id": 1, "name": "Website Redesign"}`))
+	c.Assert(err, IsNil)
+	c.Assert(deal.(*Deal).Name, Equals, "Website Redesign")
+
+	for _, data := range []string{"", "null"} {
+		resource, err := DecodeResource("deal", json.RawMessage(data))
+		c.Assert(err, IsNil)
+		c.Assert(resource, IsNil)
+	}
+
+	_, err = DecodeResource("deal", json.RawMessage(`{"id": "one"}`))
+	c.Assert(err, ErrorMatches, "basecrm: decoding deal: .*")
+}
+
+func (s *SyncSuite) TestSyncService_Fetch_Drained(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/sync/session/queues/main", func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	items, _, err := client.Sync.Fetch(syncDeviceUUID, "session", "main")
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 0)
+}
+
+func (s *SyncSuite) TestSyncService_Ack(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/sync/ack", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req, HasHttpMethod, "POST")
+		c.Assert(req, HasHttpHeader, "X-Basecrm-Device-UUID", syncDeviceUUID)
+
+		v := new(syncAckRoot)
+		json.NewDecoder(req.Body).Decode(v)
+		c.Assert(v.Data.AckKeys, DeepEquals, []string{"Deal-1-1", "Contact-2-3"})
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	acked, _, err := client.Sync.Ack(syncDeviceUUID, []string{"Deal-1-1", "Contact-2-3"})
+	c.Assert(err, IsNil)
+	c.Assert(acked, Equals, true)
+}
+
+func (s *SyncSuite) TestSync_Fetch(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/sync/start", func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"data": {"id": "session", "queues": [{"data": {"name": "main", "pages": 2, "total_count": 3}}]}}`)
+	})
+
+	fetches := 0
+	mux.HandleFunc("/v2/sync/session/queues/main", func(w http.ResponseWriter, req *http.Request) {
+		fetches++
+		switch fetches {
+		case 1:
+			fmt.Fprint(w, `{"items": [
+        {"data": {"id": 1}, "meta": {"type": "deal", "sync": {"event_type": "created", "ack_key": "Deal-1-1", "revision": 1}}},
+        {"data": {"id": 2}, "meta": {"type": "lead", "sync": {"event_type": "created", "ack_key": "Lead-2-1", "revision": 1}}}]}`)
+		case 2:
+			fmt.Fprint(w, `{"items": [
+        {"data": {"id": 3}, "meta": {"type": "task", "sync": {"event_type": "updated", "ack_key": "Task-3-2", "revision": 2}}}]}`)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	var acked [][]string
+	mux.HandleFunc("/v2/sync/ack", func(w http.ResponseWriter, req *http.Request) {
+		v := new(syncAckRoot)
+		json.NewDecoder(req.Body).Decode(v)
+		acked = append(acked, v.Data.AckKeys)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	var types []string
+	err := NewSync(client, syncDeviceUUID).Fetch(func(item *SyncItem) bool {
+		types = append(types, item.Meta.Type)
+		_, isLead := item.Resource.(*Lead)
+		return !isLead
+	})
+	c.Assert(err, IsNil)
+	c.Assert(types, DeepEquals, []string{"deal", "lead", "task"})
+	c.Assert(fetches, Equals, 3)
+	c.Assert(acked, DeepEquals, [][]string{{"Deal-1-1"}, {"Task-3-2"}})
+}
+
+func (s *SyncSuite) TestSync_Fetch_NothingToSync(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/sync/start", func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := NewSync(client, syncDeviceUUID).Fetch(func(item *SyncItem) bool {
+		c.Fatal("handler called without a session")
+		return true
+	})
+	c.Assert(err, IsNil)
+}
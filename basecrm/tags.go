@@ -1,9 +1,9 @@
 package basecrm
 
 import (
+	"context"
 	"fmt"
 	"net/http"
-	"time"
 )
 
 type Tag struct {
@@ -11,8 +11,17 @@ type Tag struct {
 	CreatorId    int          `json:"creator_id,omitempty"`
 	ResourceType ResourceType `json:"resource_type,omitempty"`
 	Name         string       `json:"name,omitempty"`
-	UpdatedAt    time.Time    `json:"updated_at,omitempty"`
-	CreatedAt    time.Time    `json:"created_at,omitempty"`
+	UpdatedAt    Timestamp    `json:"updated_at,omitempty"`
+	CreatedAt    Timestamp    `json:"created_at,omitempty"`
+
+	ForceSendFields []string `json:"-"`
+	NullFields      []string `json:"-"`
+}
+
+// MarshalJSON encodes the Tag, sending the fields listed in ForceSendFields and NullFields.
+func (t Tag) MarshalJSON() ([]byte, error) {
+	type tag Tag
+	return marshalFields(tag(t), t.ForceSendFields, t.NullFields)
 }
 
 type TagListOptions struct {
@@ -27,10 +36,17 @@ type TagListOptions struct {
 
 type TagsService interface {
 	List(opt *TagListOptions) ([]*Tag, *Response, error)
+	ListContext(ctx context.Context, opt *TagListOptions) ([]*Tag, *Response, error)
+	ListAll(opt *TagListOptions) *Iter[*Tag]
+	ListAllContext(ctx context.Context, opt *TagListOptions) *Iter[*Tag]
 	Get(id int) (*Tag, *Response, error)
+	GetContext(ctx context.Context, id int) (*Tag, *Response, error)
 	Create(tag *Tag) (*Tag, *Response, error)
+	CreateContext(ctx context.Context, tag *Tag) (*Tag, *Response, error)
 	Edit(id int, tag *Tag) (*Tag, *Response, error)
+	EditContext(ctx context.Context, id int, tag *Tag) (*Tag, *Response, error)
 	Delete(id int) (bool, *Response, error)
+	DeleteContext(ctx context.Context, id int) (bool, *Response, error)
 }
 
 func NewTagsService(client *Client) TagsService {
@@ -62,12 +78,16 @@ type TagsServiceOp struct {
 }
 
 func (s *TagsServiceOp) List(opt *TagListOptions) ([]*Tag, *Response, error) {
+	return s.ListContext(context.Background(), opt)
+}
+
+func (s *TagsServiceOp) ListContext(ctx context.Context, opt *TagListOptions) ([]*Tag, *Response, error) {
 	u, err := addOptions("/v2/tags", opt)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -78,12 +98,34 @@ func (s *TagsServiceOp) List(opt *TagListOptions) ([]*Tag, *Response, error) {
 		return nil, res, err
 	}
 
+	res.setMeta(root.Meta)
+
 	return root.Tags(), res, err
 }
 
+func (s *TagsServiceOp) ListAll(opt *TagListOptions) *Iter[*Tag] {
+	return s.ListAllContext(context.Background(), opt)
+}
+
+func (s *TagsServiceOp) ListAllContext(ctx context.Context, opt *TagListOptions) *Iter[*Tag] {
+	o := TagListOptions{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIter(ctx, o.Page, func(ctx context.Context, page int) ([]*Tag, *Response, error) {
+		o.Page = page
+		return s.ListContext(ctx, &o)
+	})
+}
+
 func (s *TagsServiceOp) Get(id int) (*Tag, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+func (s *TagsServiceOp) GetContext(ctx context.Context, id int) (*Tag, *Response, error) {
 	u := fmt.Sprintf("/v2/tags/%d", id)
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -98,9 +140,13 @@ func (s *TagsServiceOp) Get(id int) (*Tag, *Response, error) {
 }
 
 func (s *TagsServiceOp) Create(tag *Tag) (*Tag, *Response, error) {
+	return s.CreateContext(context.Background(), tag)
+}
+
+func (s *TagsServiceOp) CreateContext(ctx context.Context, tag *Tag) (*Tag, *Response, error) {
 	u := "/v2/tags"
 	envelope := &tagRoot{Tag: tag}
-	req, err := s.client.NewRequest("POST", u, envelope)
+	req, err := s.client.NewRequestContext(ctx, "POST", u, envelope)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -115,9 +161,13 @@ func (s *TagsServiceOp) Create(tag *Tag) (*Tag, *Response, error) {
 }
 
 func (s *TagsServiceOp) Edit(id int, tag *Tag) (*Tag, *Response, error) {
+	return s.EditContext(context.Background(), id, tag)
+}
+
+func (s *TagsServiceOp) EditContext(ctx context.Context, id int, tag *Tag) (*Tag, *Response, error) {
 	u := fmt.Sprintf("/v2/tags/%d", id)
 	envelope := &tagRoot{Tag: tag}
-	req, err := s.client.NewRequest("PUT", u, envelope)
+	req, err := s.client.NewRequestContext(ctx, "PUT", u, envelope)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -132,8 +182,12 @@ func (s *TagsServiceOp) Edit(id int, tag *Tag) (*Tag, *Response, error) {
 }
 
 func (s *TagsServiceOp) Delete(id int) (bool, *Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+func (s *TagsServiceOp) DeleteContext(ctx context.Context, id int) (bool, *Response, error) {
 	u := fmt.Sprintf("/v2/tags/%d", id)
-	req, err := s.client.NewRequest("DELETE", u, nil)
+	req, err := s.client.NewRequestContext(ctx, "DELETE", u, nil)
 	if err != nil {
 		return false, nil, err
 	}
@@ -1,9 +1,9 @@
 package basecrm
 
 import (
+	"context"
 	"fmt"
 	"net/http"
-	"time"
 )
 
 type Task struct {
@@ -13,14 +13,23 @@ type Task struct {
 	ResourceType ResourceType `json:"resource_type,omitempty"`
 	ResourceId   int          `json:"resource_id,omitempty"`
 	Completed    bool         `json:"completed,omitempty"`
-	CompletedAt  time.Time    `json:"completed_at,omitempty"`
-	DueDate      time.Time    `json:"due_date,omitempty"`
+	CompletedAt  Timestamp    `json:"completed_at,omitempty"`
+	DueDate      Timestamp    `json:"due_date,omitempty"`
 	Overdue      bool         `json:"overdue,omitempty"`
 	Remind       bool         `json:"remind,omitempty"`
-	RemindAt     time.Time    `json:"remind_at,omitempty"`
+	RemindAt     Timestamp    `json:"remind_at,omitempty"`
 	Content      string       `json:"content,omitempty"`
-	UpdatedAt    time.Time    `json:"updated_at,omitempty"`
-	CreatedAt    time.Time    `json:"created_at,omitempty"`
+	UpdatedAt    Timestamp    `json:"updated_at,omitempty"`
+	CreatedAt    Timestamp    `json:"created_at,omitempty"`
+
+	ForceSendFields []string `json:"-"`
+	NullFields      []string `json:"-"`
+}
+
+// MarshalJSON encodes the Task, sending the fields listed in ForceSendFields and NullFields.
+func (t Task) MarshalJSON() ([]byte, error) {
+	type task Task
+	return marshalFields(task(t), t.ForceSendFields, t.NullFields)
 }
 
 type TaskListOptions struct {
@@ -41,10 +50,17 @@ type TaskListOptions struct {
 
 type TasksService interface {
 	List(opt *TaskListOptions) ([]*Task, *Response, error)
+	ListContext(ctx context.Context, opt *TaskListOptions) ([]*Task, *Response, error)
+	ListAll(opt *TaskListOptions) *Iter[*Task]
+	ListAllContext(ctx context.Context, opt *TaskListOptions) *Iter[*Task]
 	Get(id int) (*Task, *Response, error)
+	GetContext(ctx context.Context, id int) (*Task, *Response, error)
 	Create(task *Task) (*Task, *Response, error)
+	CreateContext(ctx context.Context, task *Task) (*Task, *Response, error)
 	Edit(id int, task *Task) (*Task, *Response, error)
+	EditContext(ctx context.Context, id int, task *Task) (*Task, *Response, error)
 	Delete(id int) (bool, *Response, error)
+	DeleteContext(ctx context.Context, id int) (bool, *Response, error)
 }
 
 func NewTasksService(client *Client) TasksService {
@@ -76,12 +92,16 @@ type TasksServiceOp struct {
 }
 
 func (s *TasksServiceOp) List(opt *TaskListOptions) ([]*Task, *Response, error) {
+	return s.ListContext(context.Background(), opt)
+}
+
+func (s *TasksServiceOp) ListContext(ctx context.Context, opt *TaskListOptions) ([]*Task, *Response, error) {
 	u, err := addOptions("/v2/tasks", opt)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -92,12 +112,34 @@ func (s *TasksServiceOp) List(opt *TaskListOptions) ([]*Task, *Response, error)
 		return nil, res, err
 	}
 
+	res.setMeta(root.Meta)
+
 	return root.Tasks(), res, err
 }
 
+func (s *TasksServiceOp) ListAll(opt *TaskListOptions) *Iter[*Task] {
+	return s.ListAllContext(context.Background(), opt)
+}
+
+func (s *TasksServiceOp) ListAllContext(ctx context.Context, opt *TaskListOptions) *Iter[*Task] {
+	o := TaskListOptions{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIter(ctx, o.Page, func(ctx context.Context, page int) ([]*Task, *Response, error) {
+		o.Page = page
+		return s.ListContext(ctx, &o)
+	})
+}
+
 func (s *TasksServiceOp) Get(id int) (*Task, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+func (s *TasksServiceOp) GetContext(ctx context.Context, id int) (*Task, *Response, error) {
 	u := fmt.Sprintf("/v2/tasks/%d", id)
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -112,9 +154,13 @@ func (s *TasksServiceOp) Get(id int) (*Task, *Response, error) {
 }
 
 func (s *TasksServiceOp) Create(task *Task) (*Task, *Response, error) {
+	return s.CreateContext(context.Background(), task)
+}
+
+func (s *TasksServiceOp) CreateContext(ctx context.Context, task *Task) (*Task, *Response, error) {
 	u := "/v2/tasks"
 	envelope := &taskRoot{Task: task}
-	req, err := s.client.NewRequest("POST", u, envelope)
+	req, err := s.client.NewRequestContext(ctx, "POST", u, envelope)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -129,9 +175,13 @@ func (s *TasksServiceOp) Create(task *Task) (*Task, *Response, error) {
 }
 
 func (s *TasksServiceOp) Edit(id int, task *Task) (*Task, *Response, error) {
+	return s.EditContext(context.Background(), id, task)
+}
+
+func (s *TasksServiceOp) EditContext(ctx context.Context, id int, task *Task) (*Task, *Response, error) {
 	u := fmt.Sprintf("/v2/tasks/%d", id)
 	envelope := &taskRoot{Task: task}
-	req, err := s.client.NewRequest("PUT", u, envelope)
+	req, err := s.client.NewRequestContext(ctx, "PUT", u, envelope)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -146,8 +196,12 @@ func (s *TasksServiceOp) Edit(id int, task *Task) (*Task, *Response, error) {
 }
 
 func (s *TasksServiceOp) Delete(id int) (bool, *Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+func (s *TasksServiceOp) DeleteContext(ctx context.Context, id int) (bool, *Response, error) {
 	u := fmt.Sprintf("/v2/tasks/%d", id)
-	req, err := s.client.NewRequest("DELETE", u, nil)
+	req, err := s.client.NewRequestContext(ctx, "DELETE", u, nil)
 	if err != nil {
 		return false, nil, err
 	}
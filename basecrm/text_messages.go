@@ -0,0 +1,133 @@
+package basecrm
+
+import (
+	"context"
+	"fmt"
+)
+
+type TextMessage struct {
+	Id                  int          `json:"id,omitempty"`
+	UserId              int          `json:"user_id,omitempty"`
+	ResourceType        ResourceType `json:"resource_type,omitempty"`
+	ResourceId          int          `json:"resource_id,omitempty"`
+	AssociatedDealIds   []int        `json:"associated_deal_ids,omitempty"`
+	Content             string       `json:"content,omitempty"`
+	Incoming            bool         `json:"incoming,omitempty"`
+	ResourcePhoneNumber string       `json:"resource_phone_number,omitempty"`
+	UserPhoneNumber     string       `json:"user_phone_number,omitempty"`
+	SentAt              Timestamp    `json:"sent_at,omitempty"`
+	UpdatedAt           Timestamp    `json:"updated_at,omitempty"`
+	CreatedAt           Timestamp    `json:"created_at,omitempty"`
+}
+
+// MarshalJSON encodes the TextMessage, omitting its unset timestamps.
+func (t TextMessage) MarshalJSON() ([]byte, error) {
+	type textMessage TextMessage
+	return marshalFields(textMessage(t), nil, nil)
+}
+
+type TextMessageListOptions struct {
+	ResourceType ResourceType `url:"resource_type,omitempty"`
+	ResourceId   int          `url:"resource_id,omitempty"`
+
+	ListOptions
+}
+
+type TextMessagesService interface {
+	List(opt *TextMessageListOptions) ([]*TextMessage, *Response, error)
+	ListContext(ctx context.Context, opt *TextMessageListOptions) ([]*TextMessage, *Response, error)
+	ListAll(opt *TextMessageListOptions) *Iter[*TextMessage]
+	ListAllContext(ctx context.Context, opt *TextMessageListOptions) *Iter[*TextMessage]
+	Get(id int) (*TextMessage, *Response, error)
+	GetContext(ctx context.Context, id int) (*TextMessage, *Response, error)
+}
+
+func NewTextMessagesService(client *Client) TextMessagesService {
+	return &TextMessagesServiceOp{client}
+}
+
+type textMessageRoot struct {
+	TextMessage *TextMessage `json:"data"`
+	Meta        *struct {
+		Type string `json:"type,omitempty"`
+	} `json:"meta,omitempty"`
+}
+
+type textMessagesRoot struct {
+	Items []*textMessageRoot `json:"items"`
+	Meta  *Meta              `json:"meta"`
+}
+
+func (r *textMessagesRoot) TextMessages() []*TextMessage {
+	textMessages := make([]*TextMessage, len(r.Items))
+	for i, root := range r.Items {
+		textMessages[i] = root.TextMessage
+	}
+	return textMessages
+}
+
+type TextMessagesServiceOp struct {
+	client *Client
+}
+
+func (s *TextMessagesServiceOp) List(opt *TextMessageListOptions) ([]*TextMessage, *Response, error) {
+	return s.ListContext(context.Background(), opt)
+}
+
+func (s *TextMessagesServiceOp) ListContext(ctx context.Context, opt *TextMessageListOptions) ([]*TextMessage, *Response, error) {
+	u, err := addOptions("/v2/text_messages", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(textMessagesRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	res.setMeta(root.Meta)
+
+	return root.TextMessages(), res, err
+}
+
+func (s *TextMessagesServiceOp) ListAll(opt *TextMessageListOptions) *Iter[*TextMessage] {
+	return s.ListAllContext(context.Background(), opt)
+}
+
+func (s *TextMessagesServiceOp) ListAllContext(ctx context.Context, opt *TextMessageListOptions) *Iter[*TextMessage] {
+	o := TextMessageListOptions{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIter(ctx, o.Page, func(ctx context.Context, page int) ([]*TextMessage, *Response, error) {
+		o.Page = page
+		return s.ListContext(ctx, &o)
+	})
+}
+
+func (s *TextMessagesServiceOp) Get(id int) (*TextMessage, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+func (s *TextMessagesServiceOp) GetContext(ctx context.Context, id int) (*TextMessage, *Response, error) {
+	u := fmt.Sprintf("/v2/text_messages/%d", id)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(textMessageRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return root.TextMessage, res, err
+}
@@ -0,0 +1,125 @@
+package basecrm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+func TestTextMessagesService(t *testing.T) { TestingT(t) }
+
+type TextMessagesSuite struct {
+}
+
+var _ = Suite(&TextMessagesSuite{})
+
+func (s *TextMessagesSuite) TestTextMessagesService_List_All(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/text_messages", func(w http.ResponseWriter, req *http.Request) {
+		expected := map[string]string{
+			"resource_type": "contact",
+			"resource_id":   "1",
+			"page":          "1",
+			"per_page":      "25",
+			"ids":           "1,2,3",
+			"sort_by":       "name:desc,created_at:asc",
+		}
+		c.Assert(req, HasHttpMethod, "GET")
+		c.Assert(req, HasHttpHeader, "Accept", "application/json")
+		c.Assert(req, HasQueryParams, expected)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+     {
+      "items": [{
+          "data": {
+            "id": 1
+          },
+          "meta": {
+            "type": "text_message"
+          }
+      }, {
+          "data": {
+            "id": 2
+          },
+          "meta": {
+            "type": "text_message"
+          }
+      }],
+      "meta": {
+        "type": "collection",
+        "count": 2,
+        "links": {
+          "self": "http://api.getbase.com/v2/text_messages.json"
+        }
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	opt := &TextMessageListOptions{
+		ResourceType: ContactResource,
+		ResourceId:   1,
+		ListOptions: ListOptions{
+			Page:    1,
+			PerPage: 25,
+			Ids:     []int{1, 2, 3},
+			SortBy:  []string{"name:desc", "created_at:asc"},
+		},
+	}
+	textMessages, res, err := client.TextMessages.List(opt)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(textMessages, NotNil)
+
+	c.Assert(len(textMessages), Equals, 2)
+	c.Assert(textMessages[0].Id, Equals, 1)
+	c.Assert(textMessages[1].Id, Equals, 2)
+}
+
+func (s *TextMessagesSuite) TestTextMessagesService_Get(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/text_messages/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "user_id": 1,
+        "resource_type": "lead",
+        "resource_id": 2,
+        "content": "See you tomorrow",
+        "incoming": true,
+        "resource_phone_number": "+48111222333",
+        "sent_at": "2014-09-28T16:32:56Z"
+      },
+      "meta": {
+        "type": "text_message"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	textMessage, res, err := client.TextMessages.Get(1)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(textMessage, NotNil)
+
+	c.Assert(textMessage.Id, Equals, 1)
+	c.Assert(textMessage.ResourceType, Equals, LeadResource)
+	c.Assert(textMessage.ResourceId, Equals, 2)
+	c.Assert(textMessage.Content, Equals, "See you tomorrow")
+	c.Assert(textMessage.Incoming, Equals, true)
+}
@@ -0,0 +1,181 @@
+package basecrm
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// dateFormat is the format of date-only values.
+const dateFormat = "2006-01-02"
+
+// Timestamp is a point in time sent by the API, such as the creation time of a
+// resource. The zero value is unset: it is decoded from JSON null and encoded as
+// null, and omitted from the models sent to the API.
+type Timestamp struct {
+	time.Time
+}
+
+// NewTimestamp returns the timestamp of t.
+func NewTimestamp(t time.Time) Timestamp {
+	return Timestamp{t}
+}
+
+// MarshalJSON encodes t in RFC 3339 format, or as null when unset.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return t.Time.MarshalJSON()
+}
+
+// UnmarshalJSON decodes an RFC 3339 or a date-only string. Null and empty strings
+// decode as unset.
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*t = Timestamp{}
+		return nil
+	}
+
+	s, err := strconv.Unquote(string(data))
+	if err != nil {
+		return fmt.Errorf("basecrm: invalid timestamp %s", data)
+	}
+	return t.UnmarshalText([]byte(s))
+}
+
+// MarshalText formats t in RFC 3339 format, or as an empty text when unset.
+func (t Timestamp) MarshalText() ([]byte, error) {
+	if t.IsZero() {
+		return nil, nil
+	}
+	return t.Time.MarshalText()
+}
+
+// UnmarshalText parses an RFC 3339 or a date-only text. An empty text decodes as unset.
+func (t *Timestamp) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*t = Timestamp{}
+		return nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339Nano, string(text))
+	if err != nil {
+		if parsed, err = time.Parse(dateFormat, string(text)); err != nil {
+			return fmt.Errorf("basecrm: invalid timestamp %q", text)
+		}
+	}
+	t.Time = parsed
+	return nil
+}
+
+// Date is a calendar date without a time of day, such as the estimated close date
+// of a deal. The zero value is unset, like the zero value of Timestamp.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// NewDate returns the date of the given year, month and day.
+func NewDate(year int, month time.Month, day int) Date {
+	return Date{Year: year, Month: month, Day: day}
+}
+
+// DateOf returns the date of t in its location.
+func DateOf(t time.Time) Date {
+	year, month, day := t.Date()
+	return Date{Year: year, Month: month, Day: day}
+}
+
+// ParseDate parses a date in the "2006-01-02" format.
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse(dateFormat, s)
+	if err != nil {
+		return Date{}, fmt.Errorf("basecrm: invalid date %q", s)
+	}
+	return DateOf(t), nil
+}
+
+// In returns the time at midnight of d in loc.
+func (d Date) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// AddDays returns d plus the given number of days, which can be negative.
+func (d Date) AddDays(days int) Date {
+	return DateOf(d.In(time.UTC).AddDate(0, 0, days))
+}
+
+// Before reports whether d is before d2.
+func (d Date) Before(d2 Date) bool {
+	return d.In(time.UTC).Before(d2.In(time.UTC))
+}
+
+// After reports whether d is after d2.
+func (d Date) After(d2 Date) bool {
+	return d2.Before(d)
+}
+
+// IsZero reports whether d is unset.
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// String formats d in the "2006-01-02" format, or as an empty string when unset.
+func (d Date) String() string {
+	if d.IsZero() {
+		return ""
+	}
+	return d.In(time.UTC).Format(dateFormat)
+}
+
+// MarshalJSON encodes d in the "2006-01-02" format, or as null when unset.
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+// UnmarshalJSON decodes a date string. Dates with a time of day keep only the date,
+// and null and empty strings decode as unset.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*d = Date{}
+		return nil
+	}
+
+	s, err := strconv.Unquote(string(data))
+	if err != nil {
+		return fmt.Errorf("basecrm: invalid date %s", data)
+	}
+	return d.UnmarshalText([]byte(s))
+}
+
+// MarshalText formats d like String.
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses a date text, like UnmarshalJSON.
+func (d *Date) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*d = Date{}
+		return nil
+	}
+
+	if len(text) > len(dateFormat) {
+		if t, err := time.Parse(time.RFC3339Nano, string(text)); err == nil {
+			*d = DateOf(t)
+			return nil
+		}
+	}
+
+	parsed, err := ParseDate(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
@@ -0,0 +1,107 @@
+package basecrm
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func TestTimestamp(t *testing.T) { TestingT(t) }
+
+type TimestampSuite struct {
+}
+
+var _ = Suite(&TimestampSuite{})
+
+func (s *TimestampSuite) TestTimestamp_JSON(c *C) {
+	var values struct {
+		Set   Timestamp `json:"set"`
+		Date  Timestamp `json:"date"`
+		Null  Timestamp `json:"null"`
+		Empty Timestamp `json:"empty"`
+	}
+	err := json.Unmarshal([]byte(`{"set": "2014-08-27T16:32:56Z", "date": "2014-08-27", "null": null, "empty": ""}`), &values)
+	c.Assert(err, IsNil)
+	c.Assert(values.Set.Time, Equals, time.Date(2014, 8, 27, 16, 32, 56, 0, time.UTC))
+	c.Assert(values.Date.Time, Equals, time.Date(2014, 8, 27, 0, 0, 0, 0, time.UTC))
+	c.Assert(values.Null.IsZero(), Equals, true)
+	c.Assert(values.Empty.IsZero(), Equals, true)
+
+	data, err := json.Marshal(values)
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, `{"set":"2014-08-27T16:32:56Z","date":"2014-08-27T00:00:00Z","null":null,"empty":null}`)
+
+	err = json.Unmarshal([]byte(`{"set": "yesterday"}`), &values)
+	c.Assert(err, ErrorMatches, `basecrm: invalid timestamp "yesterday"`)
+	err = json.Unmarshal([]byte(`{"set": 1409157176}`), &values)
+	c.Assert(err, ErrorMatches, `basecrm: invalid timestamp 1409157176`)
+}
+
+func (s *TimestampSuite) TestDate(c *C) {
+	d, err := ParseDate("2014-08-27")
+	c.Assert(err, IsNil)
+	c.Assert(d, Equals, NewDate(2014, time.August, 27))
+	c.Assert(d.String(), Equals, "2014-08-27")
+	c.Assert(d.AddDays(5).String(), Equals, "2014-09-01")
+	c.Assert(d.Before(d.AddDays(1)), Equals, true)
+	c.Assert(d.After(d.AddDays(-1)), Equals, true)
+	c.Assert(d.In(time.UTC), Equals, time.Date(2014, 8, 27, 0, 0, 0, 0, time.UTC))
+	c.Assert(DateOf(time.Date(2014, 8, 27, 23, 59, 0, 0, time.UTC)), Equals, d)
+
+	_, err = ParseDate("27/08/2014")
+	c.Assert(err, ErrorMatches, `basecrm: invalid date "27/08/2014"`)
+	c.Assert(Date{}.IsZero(), Equals, true)
+	c.Assert(Date{}.String(), Equals, "")
+}
+
+func (s *TimestampSuite) TestDate_JSON(c *C) {
+	var deal Deal
+	err := json.Unmarshal([]byte(`{"estimated_close_date": "2014-08-27", "created_at": null}`), &deal)
+	c.Assert(err, IsNil)
+	c.Assert(deal.EstimatedCloseDate, Equals, NewDate(2014, time.August, 27))
+	c.Assert(deal.CreatedAt.IsZero(), Equals, true)
+
+	err = json.Unmarshal([]byte(`{"estimated_close_date": "2014-09-01T10:00:00Z"}`), &deal)
+	c.Assert(err, IsNil)
+	c.Assert(deal.EstimatedCloseDate, Equals, NewDate(2014, time.September, 1))
+
+	data, err := json.Marshal(&Deal{Name: "Website Redesign", EstimatedCloseDate: NewDate(2014, time.August, 27)})
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, `{"estimated_close_date":"2014-08-27","name":"Website Redesign"}`)
+
+	data, err = json.Marshal(&Deal{NullFields: []string{"EstimatedCloseDate"}})
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, `{"estimated_close_date":null}`)
+}
+
+func (s *TimestampSuite) TestTask_Create(c *C) {
+	data, err := json.Marshal(&Task{Content: "Contact Tom", DueDate: NewTimestamp(time.Date(2014, 9, 27, 16, 32, 56, 0, time.UTC))})
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, `{"content":"Contact Tom","due_date":"2014-09-27T16:32:56Z"}`)
+
+	var task Task
+	err = json.Unmarshal([]byte(`{"due_date": "2014-09-27T16:32:56Z", "remind_at": null}`), &task)
+	c.Assert(err, IsNil)
+	// due dates keep their time of day, so editing a task does not move it to midnight
+	c.Assert(task.DueDate.Time, Equals, time.Date(2014, 9, 27, 16, 32, 56, 0, time.UTC))
+	c.Assert(task.RemindAt.IsZero(), Equals, true)
+}
+
+func (s *TimestampSuite) TestMarshal_OmitsUnsetTimestamps(c *C) {
+	for resourceType, model := range syncModels {
+		data, err := json.Marshal(model())
+		c.Assert(err, IsNil, Commentf("resource type %s", resourceType))
+		c.Assert(string(data), Not(Matches), `.*null.*`, Commentf("resource type %s", resourceType))
+	}
+
+	data, err := json.Marshal([]Pipeline{{Name: "Sales"}})
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, `[{"name":"Sales"}]`)
+}
+
+func (s *TimestampSuite) TestStringify(c *C) {
+	user := &User{Id: 1, CreatedAt: NewTimestamp(time.Date(2014, 8, 27, 16, 32, 56, 0, time.UTC))}
+	c.Assert(user.String(), Matches, `.*CreatedAt:"2014-08-27 16:32:56 \+0000 UTC".*`)
+}
@@ -1,8 +1,8 @@
 package basecrm
 
 import (
+	"context"
 	"fmt"
-	"time"
 )
 
 type User struct {
@@ -12,8 +12,14 @@ type User struct {
 	Status    string    `json:"status,omitempty"` // what status value can have ?
 	Role      string    `json:"role,omitempty"`   // what roles are available ?
 	Confirmed bool      `json:"confirmed,omitempty"`
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
-	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+}
+
+// MarshalJSON encodes the User, omitting its unset timestamps.
+func (u User) MarshalJSON() ([]byte, error) {
+	type user User
+	return marshalFields(user(u), nil, nil)
 }
 
 func (u *User) String() string {
@@ -32,8 +38,13 @@ type UserListOptions struct {
 
 type UsersService interface {
 	List(opt *UserListOptions) ([]*User, *Response, error)
+	ListContext(ctx context.Context, opt *UserListOptions) ([]*User, *Response, error)
+	ListAll(opt *UserListOptions) *Iter[*User]
+	ListAllContext(ctx context.Context, opt *UserListOptions) *Iter[*User]
 	Get(id int) (*User, *Response, error)
+	GetContext(ctx context.Context, id int) (*User, *Response, error)
 	Self() (*User, *Response, error)
+	SelfContext(ctx context.Context) (*User, *Response, error)
 }
 
 func NewUsersService(client *Client) UsersService {
@@ -65,12 +76,16 @@ type UsersServiceOp struct {
 }
 
 func (s *UsersServiceOp) List(opt *UserListOptions) ([]*User, *Response, error) {
+	return s.ListContext(context.Background(), opt)
+}
+
+func (s *UsersServiceOp) ListContext(ctx context.Context, opt *UserListOptions) ([]*User, *Response, error) {
 	u, err := addOptions("/v2/users", opt)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -81,12 +96,34 @@ func (s *UsersServiceOp) List(opt *UserListOptions) ([]*User, *Response, error)
 		return nil, res, err
 	}
 
+	res.setMeta(root.Meta)
+
 	return root.Users(), res, err
 }
 
+func (s *UsersServiceOp) ListAll(opt *UserListOptions) *Iter[*User] {
+	return s.ListAllContext(context.Background(), opt)
+}
+
+func (s *UsersServiceOp) ListAllContext(ctx context.Context, opt *UserListOptions) *Iter[*User] {
+	o := UserListOptions{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIter(ctx, o.Page, func(ctx context.Context, page int) ([]*User, *Response, error) {
+		o.Page = page
+		return s.ListContext(ctx, &o)
+	})
+}
+
 func (s *UsersServiceOp) Get(id int) (*User, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+func (s *UsersServiceOp) GetContext(ctx context.Context, id int) (*User, *Response, error) {
 	u := fmt.Sprintf("/v2/users/%d", id)
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -101,8 +138,12 @@ func (s *UsersServiceOp) Get(id int) (*User, *Response, error) {
 }
 
 func (s *UsersServiceOp) Self() (*User, *Response, error) {
+	return s.SelfContext(context.Background())
+}
+
+func (s *UsersServiceOp) SelfContext(ctx context.Context) (*User, *Response, error) {
 	u := "/v2/users/self"
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
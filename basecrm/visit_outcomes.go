@@ -0,0 +1,123 @@
+package basecrm
+
+import (
+	"context"
+	"fmt"
+)
+
+type VisitOutcome struct {
+	Id        int       `json:"id,omitempty"`
+	CreatorId int       `json:"creator_id,omitempty"`
+	Name      string    `json:"name,omitempty"`
+	UpdatedAt Timestamp `json:"updated_at,omitempty"`
+	CreatedAt Timestamp `json:"created_at,omitempty"`
+}
+
+// MarshalJSON encodes the VisitOutcome, omitting its unset timestamps.
+func (v VisitOutcome) MarshalJSON() ([]byte, error) {
+	type visitOutcome VisitOutcome
+	return marshalFields(visitOutcome(v), nil, nil)
+}
+
+type VisitOutcomeListOptions struct {
+	ListOptions
+}
+
+type VisitOutcomesService interface {
+	List(opt *VisitOutcomeListOptions) ([]*VisitOutcome, *Response, error)
+	ListContext(ctx context.Context, opt *VisitOutcomeListOptions) ([]*VisitOutcome, *Response, error)
+	ListAll(opt *VisitOutcomeListOptions) *Iter[*VisitOutcome]
+	ListAllContext(ctx context.Context, opt *VisitOutcomeListOptions) *Iter[*VisitOutcome]
+	Get(id int) (*VisitOutcome, *Response, error)
+	GetContext(ctx context.Context, id int) (*VisitOutcome, *Response, error)
+}
+
+func NewVisitOutcomesService(client *Client) VisitOutcomesService {
+	return &VisitOutcomesServiceOp{client}
+}
+
+type visitOutcomeRoot struct {
+	VisitOutcome *VisitOutcome `json:"data"`
+	Meta         *struct {
+		Type string `json:"type,omitempty"`
+	} `json:"meta,omitempty"`
+}
+
+type visitOutcomesRoot struct {
+	Items []*visitOutcomeRoot `json:"items"`
+	Meta  *Meta               `json:"meta"`
+}
+
+func (r *visitOutcomesRoot) VisitOutcomes() []*VisitOutcome {
+	visitOutcomes := make([]*VisitOutcome, len(r.Items))
+	for i, root := range r.Items {
+		visitOutcomes[i] = root.VisitOutcome
+	}
+	return visitOutcomes
+}
+
+type VisitOutcomesServiceOp struct {
+	client *Client
+}
+
+func (s *VisitOutcomesServiceOp) List(opt *VisitOutcomeListOptions) ([]*VisitOutcome, *Response, error) {
+	return s.ListContext(context.Background(), opt)
+}
+
+func (s *VisitOutcomesServiceOp) ListContext(ctx context.Context, opt *VisitOutcomeListOptions) ([]*VisitOutcome, *Response, error) {
+	u, err := addOptions("/v2/visit_outcomes", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(visitOutcomesRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	res.setMeta(root.Meta)
+
+	return root.VisitOutcomes(), res, err
+}
+
+func (s *VisitOutcomesServiceOp) ListAll(opt *VisitOutcomeListOptions) *Iter[*VisitOutcome] {
+	return s.ListAllContext(context.Background(), opt)
+}
+
+func (s *VisitOutcomesServiceOp) ListAllContext(ctx context.Context, opt *VisitOutcomeListOptions) *Iter[*VisitOutcome] {
+	o := VisitOutcomeListOptions{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIter(ctx, o.Page, func(ctx context.Context, page int) ([]*VisitOutcome, *Response, error) {
+		o.Page = page
+		return s.ListContext(ctx, &o)
+	})
+}
+
+func (s *VisitOutcomesServiceOp) Get(id int) (*VisitOutcome, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+func (s *VisitOutcomesServiceOp) GetContext(ctx context.Context, id int) (*VisitOutcome, *Response, error) {
+	u := fmt.Sprintf("/v2/visit_outcomes/%d", id)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(visitOutcomeRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return root.VisitOutcome, res, err
+}
@@ -0,0 +1,113 @@
+package basecrm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+func TestVisitOutcomesService(t *testing.T) { TestingT(t) }
+
+type VisitOutcomesSuite struct {
+}
+
+var _ = Suite(&VisitOutcomesSuite{})
+
+func (s *VisitOutcomesSuite) TestVisitOutcomesService_List_All(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/visit_outcomes", func(w http.ResponseWriter, req *http.Request) {
+		expected := map[string]string{
+			"page":     "1",
+			"per_page": "25",
+			"ids":      "1,2,3",
+			"sort_by":  "name:desc,created_at:asc",
+		}
+		c.Assert(req, HasHttpMethod, "GET")
+		c.Assert(req, HasHttpHeader, "Accept", "application/json")
+		c.Assert(req, HasQueryParams, expected)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+     {
+      "items": [{
+          "data": {
+            "id": 1
+          },
+          "meta": {
+            "type": "visit_outcome"
+          }
+      }, {
+          "data": {
+            "id": 2
+          },
+          "meta": {
+            "type": "visit_outcome"
+          }
+      }],
+      "meta": {
+        "type": "collection",
+        "count": 2,
+        "links": {
+          "self": "http://api.getbase.com/v2/visit_outcomes.json"
+        }
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	opt := &VisitOutcomeListOptions{
+		ListOptions: ListOptions{
+			Page:    1,
+			PerPage: 25,
+			Ids:     []int{1, 2, 3},
+			SortBy:  []string{"name:desc", "created_at:asc"},
+		},
+	}
+	visitOutcomes, res, err := client.VisitOutcomes.List(opt)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(visitOutcomes, NotNil)
+
+	c.Assert(len(visitOutcomes), Equals, 2)
+	c.Assert(visitOutcomes[0].Id, Equals, 1)
+	c.Assert(visitOutcomes[1].Id, Equals, 2)
+}
+
+func (s *VisitOutcomesSuite) TestVisitOutcomesService_Get(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/visit_outcomes/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "creator_id": 1,
+        "name": "Meeting scheduled"
+      },
+      "meta": {
+        "type": "visit_outcome"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	visitOutcome, res, err := client.VisitOutcomes.Get(1)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(visitOutcome, NotNil)
+
+	c.Assert(visitOutcome.Id, Equals, 1)
+	c.Assert(visitOutcome.Name, Equals, "Meeting scheduled")
+}
@@ -0,0 +1,136 @@
+package basecrm
+
+import (
+	"context"
+	"fmt"
+)
+
+type Visit struct {
+	Id                            int          `json:"id,omitempty"`
+	CreatorId                     int          `json:"creator_id,omitempty"`
+	OutcomeId                     int          `json:"outcome_id,omitempty"`
+	ResourceType                  ResourceType `json:"resource_type,omitempty"`
+	ResourceId                    int          `json:"resource_id,omitempty"`
+	ResourceAddress               string       `json:"resource_address,omitempty"`
+	Address                       *Address     `json:"address,omitempty"`
+	Summary                       string       `json:"summary,omitempty"`
+	RepLocationVerificationStatus string       `json:"rep_location_verification_status,omitempty"`
+	VisitedAt                     Timestamp    `json:"visited_at,omitempty"`
+	UpdatedAt                     Timestamp    `json:"updated_at,omitempty"`
+	CreatedAt                     Timestamp    `json:"created_at,omitempty"`
+}
+
+// MarshalJSON encodes the Visit, omitting its unset timestamps.
+func (v Visit) MarshalJSON() ([]byte, error) {
+	type visit Visit
+	return marshalFields(visit(v), nil, nil)
+}
+
+type VisitListOptions struct {
+	CreatorId int `url:"creator_id,omitempty"`
+	OutcomeId int `url:"outcome_id,omitempty"`
+
+	ResourceType ResourceType `url:"resource_type,omitempty"`
+	ResourceId   int          `url:"resource_id,omitempty"`
+
+	ListOptions
+}
+
+type VisitsService interface {
+	List(opt *VisitListOptions) ([]*Visit, *Response, error)
+	ListContext(ctx context.Context, opt *VisitListOptions) ([]*Visit, *Response, error)
+	ListAll(opt *VisitListOptions) *Iter[*Visit]
+	ListAllContext(ctx context.Context, opt *VisitListOptions) *Iter[*Visit]
+	Get(id int) (*Visit, *Response, error)
+	GetContext(ctx context.Context, id int) (*Visit, *Response, error)
+}
+
+func NewVisitsService(client *Client) VisitsService {
+	return &VisitsServiceOp{client}
+}
+
+type visitRoot struct {
+	Visit *Visit `json:"data"`
+	Meta  *struct {
+		Type string `json:"type,omitempty"`
+	} `json:"meta,omitempty"`
+}
+
+type visitsRoot struct {
+	Items []*visitRoot `json:"items"`
+	Meta  *Meta        `json:"meta"`
+}
+
+func (r *visitsRoot) Visits() []*Visit {
+	visits := make([]*Visit, len(r.Items))
+	for i, root := range r.Items {
+		visits[i] = root.Visit
+	}
+	return visits
+}
+
+type VisitsServiceOp struct {
+	client *Client
+}
+
+func (s *VisitsServiceOp) List(opt *VisitListOptions) ([]*Visit, *Response, error) {
+	return s.ListContext(context.Background(), opt)
+}
+
+func (s *VisitsServiceOp) ListContext(ctx context.Context, opt *VisitListOptions) ([]*Visit, *Response, error) {
+	u, err := addOptions("/v2/visits", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(visitsRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	res.setMeta(root.Meta)
+
+	return root.Visits(), res, err
+}
+
+func (s *VisitsServiceOp) ListAll(opt *VisitListOptions) *Iter[*Visit] {
+	return s.ListAllContext(context.Background(), opt)
+}
+
+func (s *VisitsServiceOp) ListAllContext(ctx context.Context, opt *VisitListOptions) *Iter[*Visit] {
+	o := VisitListOptions{}
+	if opt != nil {
+		o = *opt
+	}
+
+	return newIter(ctx, o.Page, func(ctx context.Context, page int) ([]*Visit, *Response, error) {
+		o.Page = page
+		return s.ListContext(ctx, &o)
+	})
+}
+
+func (s *VisitsServiceOp) Get(id int) (*Visit, *Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+func (s *VisitsServiceOp) GetContext(ctx context.Context, id int) (*Visit, *Response, error) {
+	u := fmt.Sprintf("/v2/visits/%d", id)
+	req, err := s.client.NewRequestContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(visitRoot)
+	res, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return root.Visit, res, err
+}
@@ -0,0 +1,130 @@
+package basecrm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+func TestVisitsService(t *testing.T) { TestingT(t) }
+
+type VisitsSuite struct {
+}
+
+var _ = Suite(&VisitsSuite{})
+
+func (s *VisitsSuite) TestVisitsService_List_All(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/visits", func(w http.ResponseWriter, req *http.Request) {
+		expected := map[string]string{
+			"creator_id":    "1",
+			"outcome_id":    "1",
+			"resource_type": "lead",
+			"resource_id":   "1",
+			"page":          "1",
+			"per_page":      "25",
+			"ids":           "1,2,3",
+			"sort_by":       "name:desc,created_at:asc",
+		}
+		c.Assert(req, HasHttpMethod, "GET")
+		c.Assert(req, HasHttpHeader, "Accept", "application/json")
+		c.Assert(req, HasQueryParams, expected)
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+     {
+      "items": [{
+          "data": {
+            "id": 1
+          },
+          "meta": {
+            "type": "visit"
+          }
+      }, {
+          "data": {
+            "id": 2
+          },
+          "meta": {
+            "type": "visit"
+          }
+      }],
+      "meta": {
+        "type": "collection",
+        "count": 2,
+        "links": {
+          "self": "http://api.getbase.com/v2/visits.json"
+        }
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	opt := &VisitListOptions{
+		CreatorId:    1,
+		OutcomeId:    1,
+		ResourceType: LeadResource,
+		ResourceId:   1,
+		ListOptions: ListOptions{
+			Page:    1,
+			PerPage: 25,
+			Ids:     []int{1, 2, 3},
+			SortBy:  []string{"name:desc", "created_at:asc"},
+		},
+	}
+	visits, res, err := client.Visits.List(opt)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(visits, NotNil)
+
+	c.Assert(len(visits), Equals, 2)
+	c.Assert(visits[0].Id, Equals, 1)
+	c.Assert(visits[1].Id, Equals, 2)
+}
+
+func (s *VisitsSuite) TestVisitsService_Get(c *C) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/visits/1", func(w http.ResponseWriter, req *http.Request) {
+		c.Assert(req.Method, Equals, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+
+		jsonBlob := `
+    {
+      "data": {
+        "id": 1,
+        "creator_id": 1,
+        "outcome_id": 2,
+        "resource_type": "contact",
+        "resource_id": 3,
+        "address": {"line1": "2726 Smith Street", "city": "Hyannis", "postal_code": "02601", "state": "MA", "country": "US"},
+        "summary": "Signed the papers",
+        "rep_location_verification_status": "VERIFIED",
+        "visited_at": "2014-09-28T16:32:56Z"
+      },
+      "meta": {
+        "type": "visit"
+      }
+    }
+    `
+		fmt.Fprintf(w, jsonBlob)
+	})
+
+	visit, res, err := client.Visits.Get(1)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+	c.Assert(visit, NotNil)
+
+	c.Assert(visit.Id, Equals, 1)
+	c.Assert(visit.OutcomeId, Equals, 2)
+	c.Assert(visit.ResourceType, Equals, ContactResource)
+	c.Assert(visit.Address.City, Equals, "Hyannis")
+	c.Assert(visit.RepLocationVerificationStatus, Equals, "VERIFIED")
+}
@@ -0,0 +1,127 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/iaintshine/basecrm-go/basecrm"
+)
+
+// OnDealCreated registers fn for created deal events.
+func (h *Handler) OnDealCreated(fn func(ctx context.Context, e *Event, deal *basecrm.Deal) error) {
+	h.On(DealType, Created, func(ctx context.Context, e *Event) error {
+		deal, _ := e.Resource.(*basecrm.Deal)
+		return fn(ctx, e, deal)
+	})
+}
+
+// OnDealUpdated registers fn for updated deal events.
+func (h *Handler) OnDealUpdated(fn func(ctx context.Context, e *Event, deal *basecrm.Deal) error) {
+	h.On(DealType, Updated, func(ctx context.Context, e *Event) error {
+		deal, _ := e.Resource.(*basecrm.Deal)
+		return fn(ctx, e, deal)
+	})
+}
+
+// OnDealDeleted registers fn for deleted deal events.
+func (h *Handler) OnDealDeleted(fn func(ctx context.Context, e *Event, deal *basecrm.Deal) error) {
+	h.On(DealType, Deleted, func(ctx context.Context, e *Event) error {
+		deal, _ := e.Resource.(*basecrm.Deal)
+		return fn(ctx, e, deal)
+	})
+}
+
+// OnContactCreated registers fn for created contact events.
+func (h *Handler) OnContactCreated(fn func(ctx context.Context, e *Event, contact *basecrm.Contact) error) {
+	h.On(ContactType, Created, func(ctx context.Context, e *Event) error {
+		contact, _ := e.Resource.(*basecrm.Contact)
+		return fn(ctx, e, contact)
+	})
+}
+
+// OnContactUpdated registers fn for updated contact events.
+func (h *Handler) OnContactUpdated(fn func(ctx context.Context, e *Event, contact *basecrm.Contact) error) {
+	h.On(ContactType, Updated, func(ctx context.Context, e *Event) error {
+		contact, _ := e.Resource.(*basecrm.Contact)
+		return fn(ctx, e, contact)
+	})
+}
+
+// OnContactDeleted registers fn for deleted contact events.
+func (h *Handler) OnContactDeleted(fn func(ctx context.Context, e *Event, contact *basecrm.Contact) error) {
+	h.On(ContactType, Deleted, func(ctx context.Context, e *Event) error {
+		contact, _ := e.Resource.(*basecrm.Contact)
+		return fn(ctx, e, contact)
+	})
+}
+
+// OnLeadCreated registers fn for created lead events.
+func (h *Handler) OnLeadCreated(fn func(ctx context.Context, e *Event, lead *basecrm.Lead) error) {
+	h.On(LeadType, Created, func(ctx context.Context, e *Event) error {
+		lead, _ := e.Resource.(*basecrm.Lead)
+		return fn(ctx, e, lead)
+	})
+}
+
+// OnLeadUpdated registers fn for updated lead events.
+func (h *Handler) OnLeadUpdated(fn func(ctx context.Context, e *Event, lead *basecrm.Lead) error) {
+	h.On(LeadType, Updated, func(ctx context.Context, e *Event) error {
+		lead, _ := e.Resource.(*basecrm.Lead)
+		return fn(ctx, e, lead)
+	})
+}
+
+// OnLeadDeleted registers fn for deleted lead events.
+func (h *Handler) OnLeadDeleted(fn func(ctx context.Context, e *Event, lead *basecrm.Lead) error) {
+	h.On(LeadType, Deleted, func(ctx context.Context, e *Event) error {
+		lead, _ := e.Resource.(*basecrm.Lead)
+		return fn(ctx, e, lead)
+	})
+}
+
+// OnTaskCreated registers fn for created task events.
+func (h *Handler) OnTaskCreated(fn func(ctx context.Context, e *Event, task *basecrm.Task) error) {
+	h.On(TaskType, Created, func(ctx context.Context, e *Event) error {
+		task, _ := e.Resource.(*basecrm.Task)
+		return fn(ctx, e, task)
+	})
+}
+
+// OnTaskUpdated registers fn for updated task events.
+func (h *Handler) OnTaskUpdated(fn func(ctx context.Context, e *Event, task *basecrm.Task) error) {
+	h.On(TaskType, Updated, func(ctx context.Context, e *Event) error {
+		task, _ := e.Resource.(*basecrm.Task)
+		return fn(ctx, e, task)
+	})
+}
+
+// OnTaskDeleted registers fn for deleted task events.
+func (h *Handler) OnTaskDeleted(fn func(ctx context.Context, e *Event, task *basecrm.Task) error) {
+	h.On(TaskType, Deleted, func(ctx context.Context, e *Event) error {
+		task, _ := e.Resource.(*basecrm.Task)
+		return fn(ctx, e, task)
+	})
+}
+
+// OnNoteCreated registers fn for created note events.
+func (h *Handler) OnNoteCreated(fn func(ctx context.Context, e *Event, note *basecrm.Note) error) {
+	h.On(NoteType, Created, func(ctx context.Context, e *Event) error {
+		note, _ := e.Resource.(*basecrm.Note)
+		return fn(ctx, e, note)
+	})
+}
+
+// OnNoteUpdated registers fn for updated note events.
+func (h *Handler) OnNoteUpdated(fn func(ctx context.Context, e *Event, note *basecrm.Note) error) {
+	h.On(NoteType, Updated, func(ctx context.Context, e *Event) error {
+		note, _ := e.Resource.(*basecrm.Note)
+		return fn(ctx, e, note)
+	})
+}
+
+// OnNoteDeleted registers fn for deleted note events.
+func (h *Handler) OnNoteDeleted(fn func(ctx context.Context, e *Event, note *basecrm.Note) error) {
+	h.On(NoteType, Deleted, func(ctx context.Context, e *Event) error {
+		note, _ := e.Resource.(*basecrm.Note)
+		return fn(ctx, e, note)
+	})
+}
@@ -0,0 +1,335 @@
+// Package webhook implements an http.Handler receiving BaseCRM webhook notifications.
+//
+// The handler verifies the signature of every notification, decodes its payload
+// into the basecrm models, drops notifications which were already handled and
+// dispatches the rest to the registered callbacks:
+//
+//	h, err := webhook.NewHandler(os.Getenv("BASECRM_WEBHOOK_SECRET"))
+//	if err != nil {
+//	  ...
+//	}
+//	h.OnDealCreated(func(ctx context.Context, e *webhook.Event, deal *basecrm.Deal) error {
+//	  ...
+//	})
+//	http.Handle("/basecrm/webhook", h)
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/iaintshine/basecrm-go/basecrm"
+)
+
+// SignatureHeader is the header carrying the hex encoded HMAC-SHA256 signature of the request body.
+const SignatureHeader = "X-Basecrm-Signature"
+
+// Event types.
+const (
+	Created = "created"
+	Updated = "updated"
+	Deleted = "deleted"
+)
+
+// Resource types with typed callbacks.
+const (
+	DealType    = "deal"
+	ContactType = "contact"
+	LeadType    = "lead"
+	TaskType    = "task"
+	NoteType    = "note"
+)
+
+const (
+	defaultMaxBodySize = 1 << 20
+	defaultStoreSize   = 10000
+)
+
+var (
+	// ErrInvalidSignature is returned by Verify when the signature does not match the body.
+	ErrInvalidSignature = errors.New("webhook: invalid signature")
+	// ErrEmptySecret is returned by NewHandler and Verify when the secret is empty.
+	ErrEmptySecret = errors.New("webhook: empty secret")
+)
+
+// Event is a single notification.
+type Event struct {
+	// Type of the resource, e.g. deal, contact or lead.
+	Type string `json:"type"`
+	// One of Created, Updated or Deleted.
+	EventType string            `json:"event_type"`
+	EventId   string            `json:"event_id"`
+	EventTime basecrm.Timestamp `json:"event_time"`
+	// Resource holds the decoded model, e.g. *basecrm.Deal, or a map[string]interface{}
+	// for unknown resource types. Nil when the event carries no data.
+	Resource interface{}     `json:"-"`
+	Data     json.RawMessage `json:"-"`
+}
+
+type eventRoot struct {
+	Data  json.RawMessage `json:"data"`
+	Event *Event          `json:"meta"`
+}
+
+// A notification carries either a single event or a batch of events.
+type payloadRoot struct {
+	eventRoot
+	Items []*eventRoot `json:"items"`
+}
+
+// Sign returns the signature of body.
+func Sign(secret, body []byte) string {
+	return hex.EncodeToString(sign(secret, body))
+}
+
+func sign(secret, body []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return mac.Sum(nil)
+}
+
+// Verify checks that signature is a valid signature of body. A "sha256=" prefix is accepted.
+// Signatures are never valid for an empty secret.
+func Verify(secret, body []byte, signature string) error {
+	if len(secret) == 0 {
+		return ErrEmptySecret
+	}
+
+	signature = strings.TrimPrefix(strings.TrimSpace(signature), "sha256=")
+	actual, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(actual, sign(secret, body)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// Decode decodes a notification payload into events.
+func Decode(body []byte) ([]*Event, error) {
+	root := new(payloadRoot)
+	if err := json.Unmarshal(body, root); err != nil {
+		return nil, fmt.Errorf("webhook: decoding payload: %w", err)
+	}
+
+	items := root.Items
+	if len(items) == 0 && root.Event != nil {
+		items = []*eventRoot{&root.eventRoot}
+	}
+
+	events := make([]*Event, 0, len(items))
+	for _, item := range items {
+		if item == nil || item.Event == nil {
+			continue
+		}
+
+		event := item.Event
+		resource, err := basecrm.DecodeResource(event.Type, item.Data)
+		if err != nil {
+			return nil, err
+		}
+		event.Resource = resource
+		event.Data = item.Data
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// EventStore records the ids of handled events, so redelivered notifications are dropped.
+// Implementations must be safe for concurrent use.
+type EventStore interface {
+	// Add records the event id and reports whether it was not recorded yet.
+	// It must be atomic, so concurrent deliveries of an event are handled once.
+	Add(eventId string) bool
+	// Remove forgets the event id, so the event is handled again when redelivered.
+	Remove(eventId string)
+}
+
+// MemoryStore is an EventStore which remembers a fixed number of the most recent event ids.
+type MemoryStore struct {
+	mu   sync.Mutex
+	ids  map[string]int // event id to its index in ring
+	ring []string
+	next int
+}
+
+// NewMemoryStore returns a MemoryStore remembering up to size event ids.
+// A size of 0 or less remembers 10000.
+func NewMemoryStore(size int) *MemoryStore {
+	if size <= 0 {
+		size = defaultStoreSize
+	}
+	return &MemoryStore{ids: make(map[string]int, size), ring: make([]string, size)}
+}
+
+// Seen reports whether the event id is recorded.
+func (s *MemoryStore) Seen(eventId string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.ids[eventId]
+	return ok
+}
+
+// Add records the event id, evicting the oldest one when the store is full.
+// It reports whether the id was not recorded yet.
+func (s *MemoryStore) Add(eventId string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.ids[eventId]; ok {
+		return false
+	}
+	if evicted := s.ring[s.next]; evicted != "" {
+		delete(s.ids, evicted)
+	}
+	s.ring[s.next] = eventId
+	s.ids[eventId] = s.next
+	s.next = (s.next + 1) % len(s.ring)
+	return true
+}
+
+// Remove forgets the event id.
+func (s *MemoryStore) Remove(eventId string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if i, ok := s.ids[eventId]; ok {
+		s.ring[i] = ""
+		delete(s.ids, eventId)
+	}
+}
+
+// EventFunc handles a single event. Returning an error makes the handler respond
+// with 500 Internal Server Error, so the notification is delivered again.
+type EventFunc func(ctx context.Context, e *Event) error
+
+// Handler is an http.Handler receiving webhook notifications. Use NewHandler
+// to create one; the zero value rejects every notification, as it has no secret.
+type Handler struct {
+	// Store used to drop events which were already handled. Defaults to a MemoryStore,
+	// set it to nil to disable deduplication.
+	Store EventStore
+	// Maximum size of a request body. Defaults to 1MB.
+	MaxBodySize int64
+	// ErrorLog is called with errors returned by callbacks, if set.
+	ErrorLog func(e *Event, err error)
+
+	secret    []byte
+	callbacks map[string]EventFunc
+	fallback  EventFunc
+}
+
+// NewHandler returns a Handler verifying notifications with the webhook secret.
+// It returns ErrEmptySecret if the secret is empty, as every notification would
+// be rejected.
+func NewHandler(secret string) (*Handler, error) {
+	if secret == "" {
+		return nil, ErrEmptySecret
+	}
+
+	return &Handler{
+		Store:       NewMemoryStore(defaultStoreSize),
+		MaxBodySize: defaultMaxBodySize,
+		secret:      []byte(secret),
+		callbacks:   map[string]EventFunc{},
+	}, nil
+}
+
+// On registers fn for events of the resource type and event type. Registering
+// a callback replaces the previous one.
+func (h *Handler) On(resourceType, eventType string, fn EventFunc) {
+	if h.callbacks == nil {
+		h.callbacks = map[string]EventFunc{}
+	}
+	h.callbacks[resourceType+"."+eventType] = fn
+}
+
+// OnEvent registers fn for events without a more specific callback.
+func (h *Handler) OnEvent(fn EventFunc) {
+	h.fallback = fn
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(req.Body, h.maxBodySize()+1))
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+	if int64(len(body)) > h.maxBodySize() {
+		http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	if err := Verify(h.secret, body, req.Header.Get(SignatureHeader)); err != nil {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	events, err := Decode(body)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	failed := false
+	for _, event := range events {
+		if err := h.dispatch(req.Context(), event); err != nil {
+			failed = true
+			if h.ErrorLog != nil {
+				h.ErrorLog(event, err)
+			}
+		}
+	}
+
+	if failed {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// dispatch calls the callback of the event, unless it was already handled.
+// Events are recorded before the callback runs, so concurrent deliveries are
+// handled once, and forgotten again when the callback fails.
+func (h *Handler) dispatch(ctx context.Context, e *Event) error {
+	dedup := h.Store != nil && e.EventId != ""
+	if dedup && !h.Store.Add(e.EventId) {
+		return nil
+	}
+
+	fn, ok := h.callbacks[e.Type+"."+e.EventType]
+	if !ok {
+		fn = h.fallback
+	}
+	if fn != nil {
+		if err := fn(ctx, e); err != nil {
+			if dedup {
+				h.Store.Remove(e.EventId)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *Handler) maxBodySize() int64 {
+	if h.MaxBodySize <= 0 {
+		return defaultMaxBodySize
+	}
+	return h.MaxBodySize
+}
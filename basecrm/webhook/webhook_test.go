@@ -0,0 +1,272 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/iaintshine/basecrm-go/basecrm"
+	. "gopkg.in/check.v1"
+)
+
+func TestWebhook(t *testing.T) { TestingT(t) }
+
+type WebhookSuite struct {
+}
+
+var _ = Suite(&WebhookSuite{})
+
+const secret = "s3cr3t"
+
+const dealCreated = `
+{
+  "data": {
+    "id": 1,
+    "name": "Website Redesign"
+  },
+  "meta": {
+    "type": "deal",
+    "event_type": "created",
+    "event_id": "e1",
+    "event_time": "2014-08-27T16:32:56Z"
+  }
+}
+`
+
+func newHandler(c *C) *Handler {
+	h, err := NewHandler(secret)
+	c.Assert(err, IsNil)
+	return h
+}
+
+func deliver(h http.Handler, body string, signature string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("POST", "/webhook", strings.NewReader(body))
+	req.Header.Set(SignatureHeader, signature)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	return w
+}
+
+func (s *WebhookSuite) TestVerify(c *C) {
+	body := []byte(dealCreated)
+	signature := Sign([]byte(secret), body)
+
+	c.Assert(Verify([]byte(secret), body, signature), IsNil)
+	c.Assert(Verify([]byte(secret), body, "sha256="+signature), IsNil)
+	c.Assert(Verify([]byte("other"), body, signature), Equals, ErrInvalidSignature)
+	c.Assert(Verify([]byte(secret), body, "not hex"), Equals, ErrInvalidSignature)
+	c.Assert(Verify([]byte(secret), body, ""), Equals, ErrInvalidSignature)
+	c.Assert(Verify(nil, body, Sign(nil, body)), Equals, ErrEmptySecret)
+}
+
+func (s *WebhookSuite) TestDecode_Batch(c *C) {
+	events, err := Decode([]byte(`{"items": [
+    {"data": {"id": 1, "first_name": "Mark"}, "meta": {"type": "contact", "event_type": "updated", "event_id": "e1"}},
+    {"data": {"id": 2, "content": "Call back"}, "meta": {"type": "task", "event_type": "deleted", "event_id": "e2"}},
+    {"data": {"id": 3, "name": "important"}, "meta": {"type": "tag", "event_type": "created", "event_id": "e3"}},
+    {"data": {"id": 4}, "meta": {"type": "webinar", "event_type": "created", "event_id": "e4"}},
+    {"meta": {"type": "deal", "event_type": "deleted", "event_id": "e5"}}]}`))
+	c.Assert(err, IsNil)
+	c.Assert(events, HasLen, 5)
+
+	c.Assert(events[0].Resource.(*basecrm.Contact).FirstName, Equals, "Mark")
+	c.Assert(events[1].Resource.(*basecrm.Task).Content, Equals, "Call back")
+	c.Assert(events[2].Resource.(*basecrm.Tag).Name, Equals, "important")
+	c.Assert(events[3].Resource, DeepEquals, map[string]interface{}{"id": float64(4)})
+	c.Assert(events[4].Resource, IsNil)
+}
+
+func (s *WebhookSuite) TestHandler_Dispatch(c *C) {
+	h := newHandler(c)
+
+	var deals []*basecrm.Deal
+	h.OnDealCreated(func(ctx context.Context, e *Event, deal *basecrm.Deal) error {
+		c.Assert(e.EventId, Equals, "e1")
+		deals = append(deals, deal)
+		return nil
+	})
+	h.OnContactUpdated(func(ctx context.Context, e *Event, contact *basecrm.Contact) error {
+		c.Fatal("unexpected contact callback")
+		return nil
+	})
+
+	w := deliver(h, dealCreated, Sign([]byte(secret), []byte(dealCreated)))
+	c.Assert(w.Code, Equals, http.StatusNoContent)
+	c.Assert(deals, HasLen, 1)
+	c.Assert(deals[0].Id, Equals, 1)
+	c.Assert(deals[0].Name, Equals, "Website Redesign")
+}
+
+func (s *WebhookSuite) TestHandler_DeletedWithoutData(c *C) {
+	h := newHandler(c)
+
+	called := false
+	h.OnDealDeleted(func(ctx context.Context, e *Event, deal *basecrm.Deal) error {
+		c.Assert(deal, IsNil)
+		called = true
+		return nil
+	})
+
+	body := `{"meta": {"type": "deal", "event_type": "deleted", "event_id": "e1"}}`
+	w := deliver(h, body, Sign([]byte(secret), []byte(body)))
+	c.Assert(w.Code, Equals, http.StatusNoContent)
+	c.Assert(called, Equals, true)
+}
+
+func (s *WebhookSuite) TestHandler_Fallback(c *C) {
+	h := newHandler(c)
+
+	var types []string
+	h.OnEvent(func(ctx context.Context, e *Event) error {
+		types = append(types, e.Type+"."+e.EventType)
+		return nil
+	})
+
+	w := deliver(h, dealCreated, Sign([]byte(secret), []byte(dealCreated)))
+	c.Assert(w.Code, Equals, http.StatusNoContent)
+	c.Assert(types, DeepEquals, []string{"deal.created"})
+}
+
+func (s *WebhookSuite) TestHandler_Deduplicate(c *C) {
+	h := newHandler(c)
+
+	calls := 0
+	h.OnDealCreated(func(ctx context.Context, e *Event, deal *basecrm.Deal) error {
+		calls++
+		return nil
+	})
+
+	signature := Sign([]byte(secret), []byte(dealCreated))
+	c.Assert(deliver(h, dealCreated, signature).Code, Equals, http.StatusNoContent)
+	c.Assert(deliver(h, dealCreated, signature).Code, Equals, http.StatusNoContent)
+	c.Assert(calls, Equals, 1)
+}
+
+func (s *WebhookSuite) TestHandler_ConcurrentDeliveries(c *C) {
+	h := newHandler(c)
+
+	var calls int32
+	h.OnDealCreated(func(ctx context.Context, e *Event, deal *basecrm.Deal) error {
+		atomic.AddInt32(&calls, 1)
+		return nil
+	})
+
+	signature := Sign([]byte(secret), []byte(dealCreated))
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			deliver(h, dealCreated, signature)
+		}()
+	}
+	wg.Wait()
+	c.Assert(atomic.LoadInt32(&calls), Equals, int32(1))
+}
+
+func (s *WebhookSuite) TestHandler_CallbackError(c *C) {
+	h := newHandler(c)
+
+	calls := 0
+	h.OnDealCreated(func(ctx context.Context, e *Event, deal *basecrm.Deal) error {
+		calls++
+		if calls == 1 {
+			return errors.New("database is down")
+		}
+		return nil
+	})
+
+	var logged error
+	h.ErrorLog = func(e *Event, err error) {
+		logged = err
+	}
+
+	signature := Sign([]byte(secret), []byte(dealCreated))
+	c.Assert(deliver(h, dealCreated, signature).Code, Equals, http.StatusInternalServerError)
+	c.Assert(logged, ErrorMatches, "database is down")
+
+	// failed events are not recorded as handled, so redelivery is processed
+	c.Assert(deliver(h, dealCreated, signature).Code, Equals, http.StatusNoContent)
+	c.Assert(calls, Equals, 2)
+}
+
+func (s *WebhookSuite) TestHandler_InvalidSignature(c *C) {
+	h := newHandler(c)
+	h.OnEvent(func(ctx context.Context, e *Event) error {
+		c.Fatal("unexpected callback")
+		return nil
+	})
+
+	w := deliver(h, dealCreated, Sign([]byte("other"), []byte(dealCreated)))
+	c.Assert(w.Code, Equals, http.StatusUnauthorized)
+}
+
+func (s *WebhookSuite) TestNewHandler_EmptySecret(c *C) {
+	h, err := NewHandler("")
+	c.Assert(h, IsNil)
+	c.Assert(err, Equals, ErrEmptySecret)
+}
+
+func (s *WebhookSuite) TestHandler_ZeroValue(c *C) {
+	h := &Handler{}
+	h.OnEvent(func(ctx context.Context, e *Event) error {
+		c.Fatal("unexpected callback")
+		return nil
+	})
+	h.OnDealCreated(func(ctx context.Context, e *Event, deal *basecrm.Deal) error {
+		c.Fatal("unexpected callback")
+		return nil
+	})
+
+	w := deliver(h, dealCreated, Sign(nil, []byte(dealCreated)))
+	c.Assert(w.Code, Equals, http.StatusUnauthorized)
+}
+
+func (s *WebhookSuite) TestHandler_InvalidRequests(c *C) {
+	h := newHandler(c)
+	h.MaxBodySize = 16
+
+	req := httptest.NewRequest("GET", "/webhook", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	c.Assert(w.Code, Equals, http.StatusMethodNotAllowed)
+
+	w = deliver(h, dealCreated, Sign([]byte(secret), []byte(dealCreated)))
+	c.Assert(w.Code, Equals, http.StatusRequestEntityTooLarge)
+
+	body := `{"data": [`
+	w = deliver(h, body, Sign([]byte(secret), []byte(body)))
+	c.Assert(w.Code, Equals, http.StatusBadRequest)
+}
+
+func (s *WebhookSuite) TestMemoryStore_Evicts(c *C) {
+	store := NewMemoryStore(2)
+	c.Assert(store.Add("e1"), Equals, true)
+	c.Assert(store.Add("e2"), Equals, true)
+	c.Assert(store.Add("e2"), Equals, false)
+	c.Assert(store.Seen("e1"), Equals, true)
+
+	c.Assert(store.Add("e3"), Equals, true)
+	c.Assert(store.Seen("e1"), Equals, false)
+	c.Assert(store.Seen("e2"), Equals, true)
+	c.Assert(store.Seen("e3"), Equals, true)
+}
+
+func (s *WebhookSuite) TestMemoryStore_Remove(c *C) {
+	store := NewMemoryStore(2)
+	store.Add("e1")
+	store.Remove("e1")
+	c.Assert(store.Seen("e1"), Equals, false)
+
+	// a removed id is not evicted by its old slot
+	store.Add("e2")
+	store.Add("e1")
+	store.Add("e3")
+	c.Assert(store.Seen("e1"), Equals, true)
+	c.Assert(store.Seen("e2"), Equals, false)
+}